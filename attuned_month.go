@@ -92,6 +92,31 @@ func (my *AttunedMonth) WeekDay(d int) time.Weekday {
 	return time.Weekday((my.unixDay(d) + firstWeekDay) / secondsPerDay % 7)
 }
 
+// YearDay returns the day of the year of the provided day, ranging from 1 to 366.
+func (my *AttunedMonth) YearDay(d int) int {
+	return int((my.unixDay(d)-my.unixYear)/secondsPerDay) + 1
+}
+
+// ISOWeek returns the ISO 8601 week number of the provided day, ranging from 1 to 53.
+// Days near the beginning or end of the year may belong to a week of the adjacent year.
+func (my *AttunedMonth) ISOWeek(d int) int {
+	// ISO weekdays range from 1 (Monday) to 7 (Sunday).
+	wd := (int(my.WeekDay(d))+6)%7 + 1
+	week := (my.YearDay(d) - wd + 10) / 7
+	switch {
+	case week < 1:
+		return isoWeeksInYear(my.y - 1)
+	case week > isoWeeksInYear(my.y):
+		return 1
+	}
+	return week
+}
+
+// UnixDay returns the amount of days between the Unix epoch and the provided day.
+func (my *AttunedMonth) UnixDay(d int) int64 {
+	return my.unixDay(d) / secondsPerDay
+}
+
 func (my *AttunedMonth) unixDay(d int) int64 {
 	return my.unixMonth + int64(d-1)*secondsPerDay
 }
@@ -120,3 +145,16 @@ func (my *AttunedMonth) determineLastDay() {
 	}
 	my.lastDay = daysPerMonth[my.mon] - daysPerMonth[my.mon-1]
 }
+
+// isoWeeksInYear determines if the provided year has 52 or 53 ISO weeks.
+// Years ending on a Thursday, or starting on one, have 53 weeks.
+func isoWeeksInYear(y int) int {
+	if yearLastWeekDay(y) == time.Thursday || yearLastWeekDay(y-1) == time.Wednesday {
+		return 53
+	}
+	return 52
+}
+
+func yearLastWeekDay(y int) time.Weekday {
+	return time.Weekday((y + y/4 - y/100 + y/400) % 7)
+}
@@ -10,9 +10,10 @@ type BetweenExpression struct {
 }
 
 // Between is an expression that generates integers between the provided parameters (*inclusive*).
+// Ranges wrapping around the end of their unit are represented by CyclicExpressions instead.
 func Between(x int, y int) *BetweenExpression {
 	if x > y {
-		panic("schedule: invalid BetweenExpression expression")
+		panic(InvalidBetweenError.Error())
 	}
 
 	return &BetweenExpression{
@@ -22,6 +23,16 @@ func Between(x int, y int) *BetweenExpression {
 	}
 }
 
+// NewBetween creates an expression that generates integers between the provided parameters (*inclusive*), using the provided stepping.
+// Unlike Between and Every, it returns an ErrorInvalidExpression instead of panicking when the parameters are invalid.
+func NewBetween(x int, y int, step int) (*BetweenExpression, error) {
+	exp := &BetweenExpression{}
+	if err := exp.set(x, y, step); err != nil {
+		return nil, err
+	}
+	return exp, nil
+}
+
 // BetweenMilliseconds uses the regular between logic, ensuring valid millisecond parameters.
 func BetweenMilliseconds(x int, y int) *BetweenExpression {
 	validateMillisecond(x)
@@ -82,6 +93,20 @@ func BetweenYears(x int, y int) *BetweenExpression {
 	return Between(x, y)
 }
 
+// BetweenYearDays uses the regular between logic, ensuring valid day of the year parameters.
+func BetweenYearDays(x int, y int) *BetweenExpression {
+	validateYearDay(x)
+	validateYearDay(y)
+	return Between(x, y)
+}
+
+// BetweenISOWeeks uses the regular between logic, ensuring valid ISO week parameters.
+func BetweenISOWeeks(x int, y int) *BetweenExpression {
+	validateISOWeek(x)
+	validateISOWeek(y)
+	return Between(x, y)
+}
+
 // Every allows optional specification of the stepping used for the between logic.
 // It's important to understand the behavior of the expression when step > 1. It may produce some unexpected values.
 // Example: Between(0,10).Every(3)
@@ -95,7 +120,7 @@ func BetweenYears(x int, y int) *BetweenExpression {
 //		- Next(10, true || false) = 10
 func (exp *BetweenExpression) Every(s int) *BetweenExpression {
 	if s < 1 {
-		panic("schedule: invalid step value")
+		panic(InvalidStepError.Error())
 	}
 	exp.step = s
 	return exp
@@ -139,3 +164,14 @@ func (exp *BetweenExpression) Contains(val int) bool {
 
 	return (exp.step - (val-exp.x)%exp.step) == exp.step
 }
+
+func (exp *BetweenExpression) set(x int, y int, step int) error {
+	if x > y {
+		return InvalidBetweenError
+	}
+	if step < 1 {
+		return InvalidStepError
+	}
+	exp.x, exp.y, exp.step = x, y, step
+	return nil
+}
@@ -0,0 +1,213 @@
+package schedule
+
+import (
+	"math"
+	"time"
+)
+
+// UnionExpression is the struct used to create expressions generating the values of any of their expressions.
+type UnionExpression struct {
+	exps []IteratorExpression
+}
+
+// IntersectExpression is the struct used to create expressions generating the values common to all their expressions.
+type IntersectExpression struct {
+	exps []IteratorExpression
+}
+
+// ExceptExpression is the struct used to create expressions generating the values of an expression, excluding the values of others.
+type ExceptExpression struct {
+	exp      IteratorExpression
+	excluded []IteratorExpression
+}
+
+// Union is an expression that generates the values of any of the provided expressions.
+// The expressions may be IteratorExpressions or single values.
+// Example: Union(Between(0, 6), 12) = 0, 1, 2, 3, 4, 5, 6, 12
+func Union(exps ...Expression) *UnionExpression {
+	return &UnionExpression{exps: iterators(exps)}
+}
+
+// Intersect is an expression that generates the values common to all the provided expressions.
+// The expressions may be IteratorExpressions or single values.
+// Example: Intersect(Between(0, 30).Every(5), Between(20, 59)) = 20, 25, 30
+func Intersect(exps ...Expression) *IntersectExpression {
+	return &IntersectExpression{exps: iterators(exps)}
+}
+
+// Except is an expression that generates the values of the provided expression, excluding the values of the excluded ones.
+// The expressions may be IteratorExpressions or single values.
+// Example: Except(Between(0, 55).Every(5), 0, 30) = 5, 10, 15, 20, 25, 35, 40, 45, 50, 55
+func Except(exp Expression, excluded ...Expression) *ExceptExpression {
+	return &ExceptExpression{exp: iterator(exp), excluded: iterators(excluded)}
+}
+
+// Next allows retrieval of the next value from this expression.
+// Expressions are stateless, the determination of their next value is based on input.
+// Given a valid expression value, the parameter inc is used to specify if it should be included in the output.
+// Given the last value of the expression or above, the last value is returned.
+// It returns the next value according to provided parameters and a boolean indicating if it is the last value.
+func (exp *UnionExpression) Next(from int, inc bool) (int, bool) {
+	return nextFound(exp.find, exp.last, from, inc)
+}
+
+// Contains verifies if the provided value belongs to this expression.
+func (exp *UnionExpression) Contains(val int) bool {
+	for _, e := range exp.exps {
+		if e.Contains(val) {
+			return true
+		}
+	}
+	return false
+}
+
+func (exp *UnionExpression) find(from int, inc bool) (int, bool) {
+	v, found := 0, false
+	for _, e := range exp.exps {
+		if next, ok := findNext(e, from, inc); ok && (!found || next < v) {
+			v, found = next, true
+		}
+	}
+	return v, found
+}
+
+func (exp *UnionExpression) last() int {
+	v := lastValue(exp.exps[0])
+	for _, e := range exp.exps[1:] {
+		v = max(v, lastValue(e))
+	}
+	return v
+}
+
+// Next allows retrieval of the next value from this expression.
+// Expressions are stateless, the determination of their next value is based on input.
+// Given a valid expression value, the parameter inc is used to specify if it should be included in the output.
+// Given the last value of the expression or above, the last value is returned.
+// It returns the next value according to provided parameters and a boolean indicating if it is the last value.
+func (exp *IntersectExpression) Next(from int, inc bool) (int, bool) {
+	return nextFound(exp.find, exp.last, from, inc)
+}
+
+// Contains verifies if the provided value belongs to this expression.
+func (exp *IntersectExpression) Contains(val int) bool {
+	for _, e := range exp.exps {
+		if !e.Contains(val) {
+			return false
+		}
+	}
+	return true
+}
+
+func (exp *IntersectExpression) find(from int, inc bool) (int, bool) {
+	v, found := findNext(exp.exps[0], from, inc)
+	for found && !exp.Contains(v) {
+		v, found = findNext(exp.exps[0], v, false)
+	}
+	return v, found
+}
+
+func (exp *IntersectExpression) last() int {
+	v := lastValue(exp.exps[0])
+	for _, e := range exp.exps[1:] {
+		v = min(v, lastValue(e))
+	}
+	return greatest(exp.find, exp.Contains, v)
+}
+
+// Next allows retrieval of the next value from this expression.
+// Expressions are stateless, the determination of their next value is based on input.
+// Given a valid expression value, the parameter inc is used to specify if it should be included in the output.
+// Given the last value of the expression or above, the last value is returned.
+// It returns the next value according to provided parameters and a boolean indicating if it is the last value.
+func (exp *ExceptExpression) Next(from int, inc bool) (int, bool) {
+	return nextFound(exp.find, exp.last, from, inc)
+}
+
+// Contains verifies if the provided value belongs to this expression.
+func (exp *ExceptExpression) Contains(val int) bool {
+	if !exp.exp.Contains(val) {
+		return false
+	}
+	for _, e := range exp.excluded {
+		if e.Contains(val) {
+			return false
+		}
+	}
+	return true
+}
+
+func (exp *ExceptExpression) find(from int, inc bool) (int, bool) {
+	v, found := findNext(exp.exp, from, inc)
+	for found && !exp.Contains(v) {
+		v, found = findNext(exp.exp, v, false)
+	}
+	return v, found
+}
+
+func (exp *ExceptExpression) last() int {
+	return greatest(exp.find, exp.Contains, lastValue(exp.exp))
+}
+
+// nextFound implements the Next function of IteratorExpressions using a function that finds the following value
+// and a function that determines the last value.
+func nextFound(find func(from int, inc bool) (int, bool), last func() int, from int, inc bool) (int, bool) {
+	v, found := find(from, inc)
+	if !found {
+		return last(), true
+	}
+	_, more := find(v, false)
+	return v, !more
+}
+
+// findNext retrieves the next value of an expression, reporting whether it follows the provided one.
+func findNext(exp IteratorExpression, from int, inc bool) (int, bool) {
+	v, _ := exp.Next(from, inc)
+	return v, v > from || inc && v == from
+}
+
+// lastValue retrieves the last value of an expression.
+func lastValue(exp IteratorExpression) int {
+	v, _ := exp.Next(math.MaxInt, true)
+	return greatest(func(from int, inc bool) (int, bool) { return findNext(exp, from, inc) }, exp.Contains, v)
+}
+
+// greatest retrieves the greatest value contained up to the provided bound, searching down to the first value found.
+// It returns the bound when no value is found.
+func greatest(find func(from int, inc bool) (int, bool), contains func(int) bool, bound int) int {
+	first, found := find(math.MinInt, true)
+	if !found {
+		return bound
+	}
+	for v := bound; v >= first; v-- {
+		if contains(v) {
+			return v
+		}
+	}
+	return bound
+}
+
+func iterators(exps []Expression) []IteratorExpression {
+	if len(exps) < 1 {
+		panic(InvalidCombinationError.Error())
+	}
+	converted := make([]IteratorExpression, len(exps))
+	for i, exp := range exps {
+		converted[i] = iterator(exp)
+	}
+	return converted
+}
+
+// iterator converts single values into IteratorExpressions.
+func iterator(exp Expression) IteratorExpression {
+	switch exp := exp.(type) {
+	case IteratorExpression:
+		return exp
+	case int:
+		return &ListExpression{values: []int{exp}}
+	case time.Month:
+		return &ListExpression{values: []int{int(exp)}}
+	case time.Weekday:
+		return &ListExpression{values: []int{int(exp)}}
+	}
+	panic(UnsupportedExpressionError.Error())
+}
@@ -0,0 +1,169 @@
+package schedule
+
+import "time"
+
+// CronBuilder is used to build CronExpressions from values that are not known to be valid, such as user configuration.
+// Unlike the CronExpression functions, it does not panic. Instead, the first invalid value is reported by Build.
+type CronBuilder struct {
+	crn *CronExpression
+	err error
+}
+
+// NewCronBuilder creates and returns a reference to a new CronBuilder.
+func NewCronBuilder() *CronBuilder {
+	return &CronBuilder{
+		crn: Cron(),
+	}
+}
+
+// Build returns the built CronExpression.
+// If any of the provided values was invalid, it returns an error identifying the first one instead.
+// Values outside of their field's range produce an *ErrorInvalidValue.
+// When no field of the expression was set, it returns the NoFieldsError.
+func (b *CronBuilder) Build() (*CronExpression, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	// every field sets the milliseconds, either directly or through its default for the finer fields.
+	if b.crn.milliseconds == nil {
+		return nil, NoFieldsError
+	}
+	return b.crn, nil
+}
+
+// EveryMillisecond sets the expression to return a date for every millisecond.
+func (b *CronBuilder) EveryMillisecond() *CronBuilder {
+	return b.OnMilliseconds(Between(0, 999))
+}
+
+// OnMilliseconds sets the expression to return a date on the provided milliseconds.
+func (b *CronBuilder) OnMilliseconds(exp Expression) *CronBuilder {
+	if b.check(exp, millisecondUnit) {
+		b.crn.OnMilliseconds(exp)
+	}
+	return b
+}
+
+// EverySecond sets the expression to return a date for every second.
+func (b *CronBuilder) EverySecond() *CronBuilder {
+	return b.OnSeconds(Between(0, 59))
+}
+
+// OnSeconds sets the expression to return a date on the provided seconds.
+func (b *CronBuilder) OnSeconds(exp Expression) *CronBuilder {
+	if b.check(exp, secondUnit) {
+		b.crn.OnSeconds(exp)
+	}
+	return b
+}
+
+// EveryMinute sets the expression to return a date for every minute.
+func (b *CronBuilder) EveryMinute() *CronBuilder {
+	return b.OnMinutes(Between(0, 59))
+}
+
+// OnMinutes sets the expression to return a date on the provided minutes.
+func (b *CronBuilder) OnMinutes(exp Expression) *CronBuilder {
+	if b.check(exp, minuteUnit) {
+		b.crn.OnMinutes(exp)
+	}
+	return b
+}
+
+// EveryHour sets the expression to return a date for every hour.
+func (b *CronBuilder) EveryHour() *CronBuilder {
+	return b.OnHours(Between(0, 23))
+}
+
+// OnHours sets the expression to return a date on the provided hours.
+func (b *CronBuilder) OnHours(exp Expression) *CronBuilder {
+	if b.check(exp, hourUnit) {
+		b.crn.OnHours(exp)
+	}
+	return b
+}
+
+// EveryDay sets the expression to return a date for every day.
+func (b *CronBuilder) EveryDay() *CronBuilder {
+	return b.OnDays(Between(1, 31))
+}
+
+// OnDays sets the expression to return a date on the provided days.
+func (b *CronBuilder) OnDays(exp Expression) *CronBuilder {
+	if b.check(exp, dayUnit) {
+		b.crn.OnDays(exp)
+	}
+	return b
+}
+
+// OnWeekdays sets the expression to return a date on the provided weekdays.
+func (b *CronBuilder) OnWeekdays(exp Expression) *CronBuilder {
+	if v, iOf := exp.(int); iOf {
+		exp = time.Weekday(v)
+	}
+	if b.check(exp, weekdayUnit) {
+		b.crn.OnWeekdays(exp)
+	}
+	return b
+}
+
+// EveryMonth sets the expression to return a date for every month.
+func (b *CronBuilder) EveryMonth() *CronBuilder {
+	return b.OnMonths(Between(1, 12))
+}
+
+// OnMonths sets the expression to return a date on the provided months.
+func (b *CronBuilder) OnMonths(exp Expression) *CronBuilder {
+	if b.check(exp, monthUnit) {
+		b.crn.OnMonths(exp)
+	}
+	return b
+}
+
+// OnYears sets the expression to return a date on the provided years.
+func (b *CronBuilder) OnYears(exp Expression) *CronBuilder {
+	if b.check(exp, yearUnit) {
+		b.crn.OnYears(exp)
+	}
+	return b
+}
+
+// OnYearDays sets the expression to return a date on the provided days of the year.
+func (b *CronBuilder) OnYearDays(exp Expression) *CronBuilder {
+	if b.check(exp, yearDayUnit) {
+		b.crn.OnYearDays(exp)
+	}
+	return b
+}
+
+// OnISOWeeks sets the expression to return a date on the provided ISO week numbers.
+func (b *CronBuilder) OnISOWeeks(exp Expression) *CronBuilder {
+	if b.check(exp, isoWeekUnit) {
+		b.crn.OnISOWeeks(exp)
+	}
+	return b
+}
+
+// EveryWeeks sets the expression to return dates only every provided amount of weeks, starting on the anchor date.
+func (b *CronBuilder) EveryWeeks(weeks int, anchor time.Time) *CronBuilder {
+	if b.err == nil && weeks < 1 {
+		b.err = InvalidStepError
+	}
+	if b.err == nil {
+		b.crn.EveryWeeks(weeks, anchor)
+	}
+	return b
+}
+
+// MatchDays sets how the days and weekdays of the expression are combined.
+func (b *CronBuilder) MatchDays(m DayMatching) *CronBuilder {
+	b.crn.MatchDays(m)
+	return b
+}
+
+func (b *CronBuilder) check(exp Expression, u unit) bool {
+	if b.err == nil {
+		b.err = u.checkExpression(exp)
+	}
+	return b.err == nil
+}
@@ -16,10 +16,29 @@ type CronExpression struct {
 	weekdays     Expression
 	months       Expression
 	years        Expression
+	yearDays     Expression
+	isoWeeks     Expression
+
+	dayMatching DayMatching
+
+	// weeks and weeksAnchor hold the week cadence, weeksAnchor being the day since the Unix epoch of the anchor date.
+	weeks       int
+	weeksAnchor int64
 
 	initialized *uint32
 }
 
+// DayMatching identifies how the days and weekdays of a CronExpression are combined.
+type DayMatching int
+
+const (
+	// DaysAndWeekdays requires dates to satisfy both the days and the weekdays. It is the default.
+	DaysAndWeekdays DayMatching = iota
+	// DaysOrWeekdays requires dates to satisfy either the days or the weekdays, when both are restricted.
+	// It is the behavior of Vixie and POSIX cron, used by Parse unless the day-of-month or day-of-week starts with "*".
+	DaysOrWeekdays
+)
+
 // Cron creates and returns a reference to a new CronExpression.
 func Cron() *CronExpression {
 	return &CronExpression{
@@ -32,18 +51,27 @@ func Cron() *CronExpression {
 // NewInstance creates and returns a reference to a new CronInstance for the referenced CronExpression.
 func (crn *CronExpression) NewInstance(from time.Time) *CronInstance {
 	crn.initialize()
-	return &CronInstance{
-		crn:       crn,
-		following: from,
-		location:  from.Location(),
-
-		ms:  from.Nanosecond() / int(time.Millisecond),
-		s:   from.Second(),
-		min: from.Minute(),
-		h:   from.Hour(),
-		d:   from.Day(),
-		am:  NewAttunedMonth(int(from.Month()), from.Year()),
+	crnI := &CronInstance{
+		crn:   crn,
+		am:    &AttunedMonth{},
+		empty: !crn.hasValues(),
 	}
+	crnI.SeekTo(from)
+	return crnI
+}
+
+// Matches verifies if the provided date is produced by the referenced CronExpression, to the millisecond.
+// Like CronInstances, the fields are compared in the location of the date provided.
+func (crn *CronExpression) Matches(t time.Time) bool {
+	crn.initialize()
+	am := NewAttunedMonth(int(t.Month()), t.Year())
+	return contains(crn.milliseconds, t.Nanosecond()/int(time.Millisecond)) &&
+		contains(crn.seconds, t.Second()) &&
+		contains(crn.minutes, t.Minute()) &&
+		contains(crn.hours, t.Hour()) &&
+		contains(crn.months, int(t.Month())) &&
+		contains(crn.years, t.Year()) &&
+		crn.matchesDay(am, t.Day())
 }
 
 //------Expression------//
@@ -165,6 +193,57 @@ func (crn *CronExpression) OnYears(exp Expression) *CronExpression {
 	return crn
 }
 
+// OnYearDays sets this expression to return a date on the provided days of the year, ranging from 1 to 366.
+// Dates also need to satisfy the remaining fields of this expression.
+func (crn *CronExpression) OnYearDays(exp Expression) *CronExpression {
+	if exp, iOf := exp.(int); iOf {
+		validateYearDay(exp)
+	}
+	crn.yearDays = exp
+	crn.handleHour()
+	crn.reset()
+	return crn
+}
+
+// OnISOWeeks sets this expression to return a date on the provided ISO 8601 week numbers, ranging from 1 to 53.
+// Dates also need to satisfy the remaining fields of this expression.
+// The last days of December may belong to week 1 and the first days of January to week 52 or 53.
+func (crn *CronExpression) OnISOWeeks(exp Expression) *CronExpression {
+	if exp, iOf := exp.(int); iOf {
+		validateISOWeek(exp)
+	}
+	crn.isoWeeks = exp
+	crn.handleHour()
+	crn.reset()
+	return crn
+}
+
+// EveryWeeks sets this expression to return dates only every provided amount of weeks, starting on the anchor date.
+// The weeks are counted in periods of 7 days from the anchor date, regardless of their weekday. Earlier dates are never returned.
+// Example: Cron().EveryDay().OnWeekdays(time.Friday).EveryWeeks(2, time.Date(2026, time.January, 9, 0, 0, 0, 0, time.UTC)):
+//
+//	date = 00:00:00 of Friday 2026-01-09;
+//	date = 00:00:00 of Friday 2026-01-23;
+//	...
+func (crn *CronExpression) EveryWeeks(weeks int, anchor time.Time) *CronExpression {
+	if weeks < 1 {
+		panic(InvalidStepError.Error())
+	}
+	crn.weeks = weeks
+	crn.weeksAnchor = NewAttunedMonth(int(anchor.Month()), anchor.Year()).UnixDay(anchor.Day())
+	crn.reset()
+	return crn
+}
+
+// MatchDays sets how the days and weekdays of this expression are combined.
+// Example: Cron().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday).MatchDays(DaysOrWeekdays):
+//
+//	date = 00:00:00 of the 1st, the 15th and every Wednesday
+func (crn *CronExpression) MatchDays(m DayMatching) *CronExpression {
+	crn.dayMatching = m
+	return crn
+}
+
 //------Initialization------//
 
 func (crn *CronExpression) initialize() {
@@ -278,9 +357,123 @@ func next(exp Expression, from int, inc bool) (int, bool) {
 	return exp.(int), true
 }
 
-func (crn *CronExpression) containsWeekday(wd time.Weekday) bool {
-	if weekdays, iOf := crn.weekdays.(IteratorExpression); iOf {
-		return weekdays.Contains(int(wd))
+// previous determines the greatest value of the expression below from, or equal to it when inc is set.
+// It returns false when the expression has no such value.
+func previous(exp Expression, u unit, from int, inc bool) (int, bool) {
+	if !inc {
+		from--
+	}
+	switch exp := exp.(type) {
+	case IteratorExpression:
+		// expressions only iterate forward, so the greatest x from which a value up to from follows is bisected.
+		precedes := func(x int) bool {
+			v, _ := exp.Next(x, true)
+			return v >= x && v <= from
+		}
+		lo, hi := u.min, from
+		if hi < lo || !precedes(lo) {
+			return from, false
+		}
+		for lo < hi {
+			if mid := lo + (hi-lo+1)/2; precedes(mid) {
+				lo = mid
+			} else {
+				hi = mid - 1
+			}
+		}
+		return lo, true
+	case time.Month:
+		return int(exp), int(exp) <= from
+	}
+	return exp.(int), exp.(int) <= from
+}
+
+// nextDay behaves as next, additionally supporting DayExpressions.
+func nextDay(exp Expression, am *AttunedMonth, from int, inc bool) (int, bool) {
+	if exp, iOf := exp.(DayExpression); iOf {
+		return exp.NextDay(am, from, inc)
+	}
+	return next(exp, from, inc)
+}
+
+// matchesAnyDay verifies if dates need to satisfy only one of the days and weekdays.
+func (crn *CronExpression) matchesAnyDay() bool {
+	return crn.dayMatching == DaysOrWeekdays && !isAll(crn.days, dayUnit) && !isAll(crn.weekdays, weekdayUnit)
+}
+
+// matchesDay verifies if the provided day of the month satisfies the days and weekdays, according to the DayMatching.
+func (crn *CronExpression) matchesDay(am *AttunedMonth, d int) bool {
+	if crn.matchesAnyDay() {
+		return (crn.containsDay(am, d) || crn.containsWeekday(am, d)) && crn.containsDate(am, d)
+	}
+	return crn.containsDay(am, d) && crn.containsWeekday(am, d) && crn.containsDate(am, d)
+}
+
+// containsDate verifies if the provided day satisfies the days of the year, the ISO weeks and the week cadence.
+func (crn *CronExpression) containsDate(am *AttunedMonth, d int) bool {
+	return (crn.yearDays == nil || contains(crn.yearDays, am.YearDay(d))) &&
+		(crn.isoWeeks == nil || contains(crn.isoWeeks, am.ISOWeek(d))) &&
+		(crn.weeks == 0 || crn.containsWeek(am.UnixDay(d)))
+}
+
+func (crn *CronExpression) containsWeek(day int64) bool {
+	elapsed := day - crn.weeksAnchor
+	return elapsed >= 0 && elapsed/7%int64(crn.weeks) == 0
+}
+
+// weeksAnchorDate returns the anchor date of the week cadence.
+func (crn *CronExpression) weeksAnchorDate() time.Time {
+	return time.Unix(crn.weeksAnchor*secondsPerDay, 0).UTC()
+}
+
+// hasValues verifies that the time, month and year fields of this expression each hold a value within their unit.
+// Combinations of expressions may hold none, the days being verified while determining dates instead.
+func (crn *CronExpression) hasValues() bool {
+	fields := [...]struct {
+		exp Expression
+		u   unit
+	}{
+		{crn.milliseconds, millisecondUnit},
+		{crn.seconds, secondUnit},
+		{crn.minutes, minuteUnit},
+		{crn.hours, hourUnit},
+		{crn.months, monthUnit},
+		{crn.years, yearUnit},
+	}
+	for _, f := range fields {
+		if v, _ := next(f.exp, f.u.min, true); v > f.u.max || !contains(f.exp, v) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(exp Expression, v int) bool {
+	switch exp := exp.(type) {
+	case IteratorExpression:
+		return exp.Contains(v)
+	case time.Month:
+		return int(exp) == v
+	}
+	return exp.(int) == v
+}
+
+func (crn *CronExpression) containsDay(am *AttunedMonth, d int) bool {
+	switch days := crn.days.(type) {
+	case DayExpression:
+		return days.ContainsDay(am, d)
+	case IteratorExpression:
+		return days.Contains(d)
+	}
+	return crn.days.(int) == d
+}
+
+func (crn *CronExpression) containsWeekday(am *AttunedMonth, d int) bool {
+	switch weekdays := crn.weekdays.(type) {
+	case DayExpression:
+		return weekdays.ContainsDay(am, d)
+	case IteratorExpression:
+		return weekdays.Contains(int(am.WeekDay(d)))
 	}
-	return crn.weekdays.(time.Weekday) == wd
+	return crn.weekdays.(time.Weekday) == am.WeekDay(d)
 }
@@ -1,6 +1,9 @@
 package schedule
 
 import (
+	"encoding/json"
+	"errors"
+	"math"
 	"testing"
 	"time"
 )
@@ -354,10 +357,10 @@ func TestCronInstance_Next(t *testing.T) {
 		OnMonths(time.February).
 		OnDays(28).NewInstance(startDate)
 	err := crnI.Next()
-	if err == nil || err != CronOutdatedInvalidError {
+	if err != ExhaustedError || !errors.Is(err, CronOutdatedInvalidError) {
 		t.Error("Unexpected CronExpression behavior.")
-	} else if err.Error() != "schedule: outdated or invalid CronExpression" {
-		t.Error("Unexpected ErrorOutdatedInvalidCron message.")
+	} else if err.Error() != "schedule: no following dates left" {
+		t.Error("Unexpected ErrorCron message.")
 	}
 
 	// Test invalid crnI
@@ -365,13 +368,13 @@ func TestCronInstance_Next(t *testing.T) {
 		OnYears(2018).
 		OnMonths(time.February).
 		OnDays(29).NewInstance(startDate)
-	if err := crnI.Next(); err != CronOutdatedInvalidError {
+	if err := crnI.Next(); err != ExhaustedError {
 		t.Error("Unexpected CronExpression behavior.")
 	}
 	crnI = Cron().
 		OnMonths(time.February).
 		OnDays(30).NewInstance(startDate)
-	if err := crnI.Next(); err != CronOutdatedInvalidError {
+	if err := crnI.Next(); err != UnsatisfiableError || !errors.Is(err, CronOutdatedInvalidError) {
 		t.Error("Unexpected CronExpression behavior.")
 	}
 }
@@ -558,10 +561,8 @@ func TestSchedule_Next(t *testing.T) {
 	}
 
 	err := sch.Next()
-	if err == nil || err != OutdatedError {
+	if err != ExhaustedError || !errors.Is(err, OutdatedError) {
 		t.Error("Unexpected Schedule behavior.")
-	} else if err.Error() != "schedule: outdated or invalid Schedule" {
-		t.Error("Unexpected ErrorOutdated message.")
 	}
 }
 
@@ -580,6 +581,1927 @@ func TestInPanicAtLeastOneDurationRequired(t *testing.T) {
 	In()
 }
 
+func TestParse(t *testing.T) {
+	var crnI *CronInstance
+	var startDate = date().Time
+	var expectedAt time.Time
+
+	// At every 15th minute past every hour from 9 through 17 on every day-of-week from Monday through Friday.
+	crn, err := Parse("*/15 9-17 * * 1-5")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	crnI = crn.NewInstance(startDate)
+	expectedAt = date().setHour(9).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setHour(17).setMinute(45).Time
+	if crnI.advanceX(t, 35) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setHour(9).setDay(2).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	// At minute 0 past hour 0 and 12 on day-of-month 1 in every 2nd month.
+	crn, err = Parse("0 0,12 1 2/2 *")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	crnI = crn.NewInstance(startDate)
+	expectedAt = date().setMonth(time.February).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setHour(12).setMonth(time.February).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setMonth(time.February).setYear(2020).Time
+	if crnI.advanceX(t, 11) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	// At 04:05 on Sunday.
+	crn, err = Parse("5 4 * * 0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	crnI = crn.NewInstance(startDate)
+	expectedAt = date().setMinute(5).setHour(4).setDay(6).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	// At minute 10, 20, 30 and 45.
+	crn, err = Parse("10-30/10,45 * * * *")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	crnI = crn.NewInstance(startDate)
+	expectedAt = date().setMinute(45).Time
+	if crnI.advanceX(t, 4) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setMinute(10).setHour(1).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+}
+
+func TestParseError(t *testing.T) {
+	cases := []struct {
+		spec     string
+		field    string
+		position int
+	}{
+		{"* * * *", "", 7},
+		{"* * * * * *", "", 10},
+		{"60 * * * *", "minute", 0},
+		{"* 1,24 * * *", "hour", 4},
+		{"* * 0 * *", "day", 4},
+		{"* * * 1-13 *", "month", 8},
+		{"* * * * 1-x", "weekday", 10},
+		{"*/0 * * * *", "minute", 2},
+		{"5-1 * * * *", "minute", 0},
+		{"* 1,,2 * * *", "hour", 4},
+	}
+	for _, c := range cases {
+		_, err := Parse(c.spec)
+		parseErr, iOf := err.(*ErrorParse)
+		if !iOf {
+			t.Errorf("Expected ErrorParse for %q.", c.spec)
+			continue
+		}
+		if parseErr.Field != c.field || parseErr.Position != c.position {
+			t.Errorf("Unexpected ErrorParse for %q: %s", c.spec, err.Error())
+		}
+	}
+
+	_, err := Parse("* * 32 * *")
+	if err == nil || err.Error() != "schedule: invalid day field at position 4: value 32 out of range [1-31]" {
+		t.Error("Unexpected ErrorParse message.")
+	}
+}
+
+func TestParseQuartz(t *testing.T) {
+	var crnI *CronInstance
+	var startDate = date().Time
+	var expectedAt time.Time
+
+	// At 10:15:30 on every day-of-week from Monday through Friday in 2019.
+	crn, err := ParseQuartz("30 15 10 ? * 2-6 2019")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	crnI = crn.NewInstance(startDate)
+	expectedAt = date().setHour(10).setMinute(15).setSecond(30).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setHour(10).setMinute(15).setSecond(30).setDay(4).Time
+	if crnI.advanceX(t, 3) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setHour(10).setMinute(15).setSecond(30).setDay(7).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	crnI = crn.NewInstance(date().setYear(2019).setMonth(time.December).setDay(31).setHour(11).Time)
+	if err := crnI.Next(); err != ExhaustedError {
+		t.Error("Unexpected CronExpression behavior.")
+	}
+
+	// At every 20th second on day-of-month 15.
+	crn, err = ParseQuartz("*/20 * * 15 * ?")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	crnI = crn.NewInstance(startDate)
+	expectedAt = date().setDay(15).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setDay(15).setSecond(40).Time
+	if crnI.advanceX(t, 2) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setDay(15).setMinute(1).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	// At 00:00:00 on Sunday.
+	crn, err = ParseQuartz("0 0 0 ? * 1")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	crnI = crn.NewInstance(startDate)
+	expectedAt = date().setDay(6).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+}
+
+func TestParseQuartzError(t *testing.T) {
+	cases := []struct {
+		spec     string
+		field    string
+		position int
+	}{
+		{"0 * * * *", "", 9},
+		{"0 * * * * ? * *", "", 14},
+		{"60 * * * * ?", "second", 0},
+		{"0 * * * * 0", "weekday", 10},
+		{"0 * * * * 8", "weekday", 10},
+		{"0 * * * * ? 1969", "year", 12},
+		{"0 ? * * * *", "minute", 2},
+		{"0 * * ?/2 * *", "day", 7},
+	}
+	for _, c := range cases {
+		_, err := ParseQuartz(c.spec)
+		parseErr, iOf := err.(*ErrorParse)
+		if !iOf {
+			t.Errorf("Expected ErrorParse for %q.", c.spec)
+			continue
+		}
+		if parseErr.Field != c.field || parseErr.Position != c.position {
+			t.Errorf("Unexpected ErrorParse for %q: %s", c.spec, err.Error())
+		}
+	}
+}
+
+func TestParseMacros(t *testing.T) {
+	var startDate = date().Time
+	macros := []struct {
+		spec       string
+		expectedAt time.Time
+	}{
+		{"@yearly", date().setYear(2020).Time},
+		{"@annually", date().setYear(2020).Time},
+		{"@monthly", date().setMonth(time.February).Time},
+		{"@weekly", date().setDay(6).Time},
+		{"@daily", date().setDay(2).Time},
+		{"@midnight", date().setDay(2).Time},
+		{"@hourly", date().setHour(1).Time},
+	}
+	for _, m := range macros {
+		crn, err := Parse(m.spec)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %s", m.spec, err.Error())
+			continue
+		}
+		if crn.NewInstance(startDate).advanceX(t, 1) != m.expectedAt {
+			t.Errorf("Unexpected CronExpression date returned for %q.", m.spec)
+		}
+	}
+
+	for _, spec := range []string{"@every 1h", "@fortnightly", "@daily *"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Expected ErrorParse for %q.", spec)
+		}
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	sch, err := ParseSchedule("@every 1h30m")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt := time.Now().Add(time.Hour + time.Minute*30)
+	if toMilliseconds(sch.advanceX(t, 1)) != toMilliseconds(expectedAt) {
+		t.Error("Unexpected Schedule date returned.")
+	}
+	expectedAt = expectedAt.Add((time.Hour + time.Minute*30) * 2)
+	if toMilliseconds(sch.advanceX(t, 2)) != toMilliseconds(expectedAt) {
+		t.Error("Unexpected Schedule date returned.")
+	}
+
+	sch, err = ParseSchedule("@daily")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt = date().overwrite(time.Now().Add(time.Hour * 24)).setHour(0).setMinute(0).setSecond(0).setMillisecond(0).Time
+	if sch.Following() != expectedAt {
+		t.Error("Unexpected Schedule date returned.")
+	}
+
+	for _, spec := range []string{"@every", "@every 0s", "@every 1x", "@every 1h 2h", "* * *"} {
+		if _, err := ParseSchedule(spec); err == nil {
+			t.Errorf("Expected ErrorParse for %q.", spec)
+		}
+	}
+}
+
+func TestCronExpression_String(t *testing.T) {
+	crons := []struct {
+		crn      *CronExpression
+		expected string
+	}{
+		{Cron().OnMinutes(23).OnHours(Between(0, 20).Every(2)), "23 0-20/2 * * *"},
+		{Cron().OnMinutes(5).OnHours(4).OnWeekdays(time.Sunday), "5 4 * * 0"},
+		{Cron().OnMinutes(5).EveryDay().OnMonths(time.August), "5 0 * 8 *"},
+		{Cron().OnHours(22).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)), "0 22 * * 1-5"},
+		{Cron().OnHours(ListHours(0, 12)).OnMonths(BetweenMonths(time.February, time.December).Every(2)), "0 0,12 1 2-12/2 *"},
+		{Cron().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday), "0 0 0 1,15 * 4 *"},
+		{Cron().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday).MatchDays(DaysOrWeekdays), "0 0 1,15 * 3"},
+		{Cron().OnMinutes(Between(0, 59).Every(15)), "*/15,59 * * * *"},
+		{Cron().OnMinutes(Between(0, 45).Every(15)), "*/15 * * * *"},
+		{Cron().EveryMinute(), "* * * * *"},
+		{Cron().OnSeconds(30).OnMinutes(15).OnHours(10).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)), "30 15 10 ? * 2-6 *"},
+		{Cron().OnMonths(time.February).OnDays(29).OnYears(BetweenYears(2020, 2040).Every(4)), "0 0 0 29 2 ? 2020-2040/4"},
+		{Cron().EverySecond().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday), "* 0 0 1,15 * 4 *"},
+	}
+	for _, c := range crons {
+		if c.crn.String() != c.expected {
+			t.Errorf("Unexpected CronExpression string %q, expected %q.", c.crn.String(), c.expected)
+		}
+	}
+
+	if Cron().EveryMinute().Format(QuartzFormat) != "0 * * * * ? *" {
+		t.Error("Unexpected CronExpression Quartz format.")
+	}
+	if Cron().OnSeconds(30).OnYears(2020).Format(StandardFormat) != "0 0 1 1 *" {
+		t.Error("Unexpected CronExpression standard format.")
+	}
+}
+
+func TestCronExpression_TextUnrepresentable(t *testing.T) {
+	parsed, _ := Parse("0 0 1,15 * 3")
+	if text, err := parsed.Text(); err != nil || text != "0 0 1,15 * 3" {
+		t.Errorf("Unexpected CronExpression text %q (%v).", text, err)
+	}
+	if text, err := Cron().OnSeconds(30).OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday).Text(); err != nil ||
+		text != "30 0 0 1,15 * 4 *" {
+		t.Errorf("Unexpected CronExpression text %q (%v).", text, err)
+	}
+
+	parsed, _ = Parse("0 0 1,15 * 3")
+	crons := [...]*CronExpression{
+		parsed.OnSeconds(30),
+		Cron().OnMilliseconds(500).EverySecond(),
+		Cron().EveryDay().OnYearDays(100),
+		Cron().EveryDay().OnISOWeeks(1),
+		Cron().OnWeekdays(time.Friday).EveryWeeks(2, date().Time),
+		Cron().EveryDay().OnDays(customDays{}),
+	}
+	for i, crn := range crons {
+		if _, err := crn.Text(); err != UnrepresentableError {
+			t.Errorf("Expression %d: expected UnrepresentableError.", i)
+		}
+		if _, err := crn.MarshalText(); err != UnrepresentableError {
+			t.Errorf("Expression %d: expected UnrepresentableError when marshaling.", i)
+		}
+	}
+}
+
+// customDays is a DayExpression without a textual representation.
+type customDays struct{}
+
+func (customDays) NextDay(am *AttunedMonth, from int, inc bool) (int, bool) {
+	return LastDay().NextDay(am, from, inc)
+}
+
+func (customDays) ContainsDay(am *AttunedMonth, d int) bool {
+	return LastDay().ContainsDay(am, d)
+}
+
+func TestCronExpression_StringRoundTrip(t *testing.T) {
+	crons := [...]*CronExpression{
+		Cron().OnMinutes(23).OnHours(Between(0, 20).Every(2)),
+		Cron().OnMinutes(Between(0, 59).Every(15)),
+		Cron().OnMinutes(5).OnHours(4).OnWeekdays(time.Sunday),
+		Cron().OnHours(ListHours(0, 12)).OnMonths(BetweenMonths(time.February, time.December).Every(2)),
+		Cron().OnSeconds(BetweenSeconds(10, 50).Every(20)).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)),
+		Cron().OnMonths(time.February).OnDays(29).OnYears(BetweenYears(2020, 2040).Every(4)),
+	}
+	for _, crn := range crons {
+		var parsed *CronExpression
+		var err error
+		if spec := crn.String(); len(tokenize(spec)) == len(standardFields) {
+			parsed, err = Parse(spec)
+		} else {
+			parsed, err = ParseQuartz(spec)
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %s", crn.String(), err.Error())
+			continue
+		}
+		crnI, parsedI := crn.NewInstance(date().Time), parsed.NewInstance(date().Time)
+		for x := 0; x < 50; x++ {
+			err, parsedErr := crnI.Next(), parsedI.Next()
+			if err != parsedErr || crnI.Following() != parsedI.Following() {
+				t.Errorf("Unexpected round trip date for %q.", crn.String())
+				break
+			}
+			if err != nil {
+				break
+			}
+		}
+	}
+}
+
+func TestCronExpression_Describe(t *testing.T) {
+	crons := []struct {
+		crn      *CronExpression
+		expected string
+	}{
+		{Cron().OnMonths(time.February).OnDays(29).OnWeekdays(time.Sunday), "At 00:00 on day-of-month 29 and on Sunday in February."},
+		{Cron().OnMinutes(23).OnHours(Between(0, 20).Every(2)), "At minute 23 past every 2nd hour from 0 through 20."},
+		{Cron().OnMinutes(5).OnHours(4).OnWeekdays(time.Sunday), "At 04:05 on Sunday."},
+		{Cron().OnMinutes(5).EveryDay().OnMonths(time.August), "At 00:05 in August."},
+		{Cron().OnMinutes(15).OnHours(14).OnDays(1), "At 14:15 on day-of-month 1."},
+		{Cron().OnHours(22).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)), "At 22:00 on every day-of-week from Monday through Friday."},
+		{Cron().OnHours(ListHours(0, 12)).OnMonths(BetweenMonths(time.February, time.December).Every(2)), "At minute 0 past hour 0 and 12 on day-of-month 1 in every 2nd month from February through December."},
+		{Cron().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday), "At 00:00 on day-of-month 1 and 15 and on Wednesday."},
+		{Cron().OnMonths(time.January), "At 00:00 on day-of-month 1 in January."},
+		{Cron().EveryMonth(), "At 00:00 on day-of-month 1."},
+		{Cron().EveryDay(), "At 00:00."},
+		{Cron().EveryHour(), "At minute 0."},
+		{Cron().EveryMinute(), "At every minute."},
+		{Cron().EverySecond(), "At every second."},
+		{Cron().EveryMillisecond(), "At every millisecond."},
+		{Cron().OnMinutes(Between(0, 45).Every(15)).OnHours(BetweenHours(9, 17)), "At every 15th minute past every hour from 9 through 17."},
+		{Cron().OnSeconds(30).OnMinutes(15).OnHours(10), "At 10:15:30."},
+		{Cron().OnMilliseconds(250).OnSeconds(30).OnMinutes(15).OnHours(10), "At 10:15:30.250."},
+		{Cron().OnSeconds(ListSeconds(0, 20, 40)).OnHours(8), "At second 0, 20, and 40 past minute 0 past hour 8."},
+		{Cron().OnMonths(time.February).OnDays(29).OnYears(BetweenYears(2020, 2040).Every(4)), "At 00:00 on day-of-month 29 in February in every 4th year from 2020 through 2040."},
+	}
+	for _, c := range crons {
+		if c.crn.Describe() != c.expected {
+			t.Errorf("Unexpected CronExpression description %q, expected %q.", c.crn.Describe(), c.expected)
+		}
+	}
+}
+
+func TestCronExpression_DescribeIn(t *testing.T) {
+	crons := []struct {
+		crn      *CronExpression
+		loc      Locale
+		expected string
+	}{
+		{Cron().OnMonths(time.February).OnDays(29).OnWeekdays(time.Sunday), German, "Um 00:00 am 29. Tag des Monats und am Sonntag im Februar."},
+		{Cron().OnMonths(time.February).OnDays(29).OnWeekdays(time.Sunday), French, "À 00:00 le jour 29 du mois et le dimanche en février."},
+		{Cron().OnMonths(time.February).OnDays(29).OnWeekdays(time.Sunday), Portuguese, "Às 00:00 no dia 29 do mês e no domingo em fevereiro."},
+		{Cron().OnMonths(time.February).OnDays(29).OnWeekdays(time.Sunday), Japanese, "2月の29日と日曜日の00:00。"},
+		{Cron().OnMinutes(23).OnHours(Between(0, 20).Every(2)), German, "Minute 23, jede 2. Stunde von 0 bis 20."},
+		{Cron().OnMinutes(23).OnHours(Between(0, 20).Every(2)), French, "À la minute 23, toutes les 2 heures de 0 à 20."},
+		{Cron().OnMinutes(23).OnHours(Between(0, 20).Every(2)), Portuguese, "No minuto 23, a cada 2 horas de 0 a 20."},
+		{Cron().OnMinutes(23).OnHours(Between(0, 20).Every(2)), Japanese, "0時から20時までの2時間ごとの23分。"},
+		{Cron().OnHours(22).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)), German, "Um 22:00 jeden Wochentag von Montag bis Freitag."},
+		{Cron().OnHours(22).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)), French, "À 22:00 chaque jour de la semaine de lundi à vendredi."},
+		{Cron().OnHours(22).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)), Portuguese, "Às 22:00 a cada dia da semana de segunda-feira a sexta-feira."},
+		{Cron().OnHours(22).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)), Japanese, "月曜日から金曜日までの22:00。"},
+		{Cron().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday), Portuguese, "Às 00:00 nos dias 1 e 15 do mês e na quarta-feira."},
+		{Cron().OnSeconds(ListSeconds(0, 20, 40)).OnHours(8), French, "Aux secondes 0, 20 et 40, à la minute 0, à l'heure 8."},
+		{Cron().OnSeconds(ListSeconds(0, 20, 40)).OnHours(8), Japanese, "8時の0分の0秒、20秒と40秒。"},
+		{Cron().OnMonths(time.February).OnDays(29).OnYears(BetweenYears(2020, 2040).Every(4)), German, "Um 00:00 am 29. Tag des Monats im Februar jedes 4. Jahr von 2020 bis 2040."},
+		{Cron().EveryMinute(), English, "At every minute."},
+		{Cron().EveryMinute(), German, "Jede Minute."},
+		{Cron().EveryMinute(), French, "Chaque minute."},
+		{Cron().EveryMinute(), Portuguese, "A cada minuto."},
+		{Cron().EveryMinute(), Japanese, "毎分。"},
+	}
+	for _, c := range crons {
+		if c.crn.DescribeIn(c.loc) != c.expected {
+			t.Errorf("Unexpected CronExpression description %q, expected %q.", c.crn.DescribeIn(c.loc), c.expected)
+		}
+	}
+}
+
+func TestCronExpression_JSON(t *testing.T) {
+	crn := Cron().
+		OnMilliseconds(500).
+		OnSeconds(ListSeconds(0, 30)).
+		OnMinutes(Between(0, 45).Every(15)).
+		OnHours(BetweenHours(9, 17)).
+		OnWeekdays(BetweenWeekdays(time.Monday, time.Friday))
+	data, err := json.Marshal(crn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if string(data) != `{"milliseconds":"500","seconds":"0,30","minutes":"*/15","hours":"9-17","weekdays":"1-5"}` {
+		t.Errorf("Unexpected CronExpression JSON %s.", data)
+	}
+
+	decoded := &CronExpression{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err.Error())
+	}
+	crnI, decodedI := crn.NewInstance(date().Time), decoded.NewInstance(date().Time)
+	for x := 0; x < 20; x++ {
+		if crnI.advanceX(t, 1) != decodedI.advanceX(t, 1) {
+			t.Error("Unexpected decoded CronExpression date returned.")
+			break
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"hours":"24"}`), decoded); err == nil {
+		t.Error("Expected ErrorParse for invalid hours.")
+	}
+	if err := json.Unmarshal([]byte(`{}`), decoded); err != NoFieldsError {
+		t.Error("Expected NoFieldsError for an empty object.")
+	}
+	if err := json.Unmarshal([]byte(`{"hours":"5"}`), decoded); err != nil {
+		t.Fatal(err.Error())
+	}
+	from := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	if got := decoded.NewInstance(from).advanceX(t, 1); !got.Equal(time.Date(2026, time.March, 2, 5, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the finer fields to default to 0, got %v.", got)
+	}
+}
+
+func TestCronExpression_Text(t *testing.T) {
+	crn := &CronExpression{}
+	if err := crn.UnmarshalText([]byte("*/15 9-17 * * 1-5")); err != nil {
+		t.Fatal(err.Error())
+	}
+	if text, _ := crn.MarshalText(); string(text) != "*/15 9-17 * * 1-5" {
+		t.Errorf("Unexpected CronExpression text %s.", text)
+	}
+	if err := crn.UnmarshalText([]byte("30 15 10 ? * 2-6 2026")); err != nil {
+		t.Fatal(err.Error())
+	}
+	if text, _ := crn.MarshalText(); string(text) != "30 15 10 ? * 2-6 2026" {
+		t.Errorf("Unexpected CronExpression text %s.", text)
+	}
+	if err := crn.UnmarshalText([]byte("@daily")); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := crn.UnmarshalText([]byte("* * *")); err == nil {
+		t.Error("Expected ErrorParse for invalid text.")
+	}
+}
+
+func TestBetween_JSON(t *testing.T) {
+	data, err := json.Marshal(Between(0, 10).Every(3))
+	if err != nil || string(data) != `{"x":0,"y":10,"step":3}` {
+		t.Errorf("Unexpected BetweenExpression JSON %s.", data)
+	}
+	exp := &BetweenExpression{}
+	if err := json.Unmarshal(data, exp); err != nil || exp.x != 0 || exp.y != 10 || exp.step != 3 {
+		t.Error("Unexpected decoded BetweenExpression.")
+	}
+	if err := json.Unmarshal([]byte(`{"x":1,"y":0}`), exp); err != InvalidBetweenError {
+		t.Error("Expected InvalidBetweenError.")
+	}
+	if err := json.Unmarshal([]byte(`{"x":0,"y":1,"step":0}`), exp); err != InvalidStepError {
+		t.Error("Expected InvalidStepError.")
+	}
+
+	if text, _ := Between(1, 5).MarshalText(); string(text) != "1-5/1" {
+		t.Errorf("Unexpected BetweenExpression text %s.", text)
+	}
+	if err := exp.UnmarshalText([]byte("2-8/2")); err != nil || exp.x != 2 || exp.y != 8 || exp.step != 2 {
+		t.Error("Unexpected decoded BetweenExpression.")
+	}
+	for _, text := range []string{"2", "x-8", "2-y", "2-8/z", "8-2"} {
+		if err := exp.UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("Expected error for BetweenExpression text %q.", text)
+		}
+	}
+}
+
+func TestList_JSON(t *testing.T) {
+	data, err := json.Marshal(List([]int{9, 3, 6}))
+	if err != nil || string(data) != `[3,6,9]` {
+		t.Errorf("Unexpected ListExpression JSON %s.", data)
+	}
+	exp := &ListExpression{}
+	if err := json.Unmarshal([]byte(`[10,0]`), exp); err != nil || !exp.Contains(0) || !exp.Contains(10) {
+		t.Error("Unexpected decoded ListExpression.")
+	}
+	if err := json.Unmarshal([]byte(`[]`), exp); err != InvalidListError {
+		t.Error("Expected InvalidListError.")
+	}
+
+	if text, _ := List([]int{3, 6, 9}).MarshalText(); string(text) != "3,6,9" {
+		t.Errorf("Unexpected ListExpression text %s.", text)
+	}
+	if err := exp.UnmarshalText([]byte("1,x")); err == nil {
+		t.Error("Expected error for ListExpression text.")
+	}
+}
+
+func TestSchedule_JSON(t *testing.T) {
+	dt1 := date().Time
+	dt2 := date().setDay(2).Time
+	sch := At(dt1, dt2)
+	sch.AddCron(Cron().EveryDay())
+	sch.advanceX(t, 3)
+
+	data, err := json.Marshal(sch)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	decoded := &Schedule{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err.Error())
+	}
+	if !decoded.Following().Equal(sch.Following()) {
+		t.Error("Unexpected decoded Schedule date returned.")
+	}
+	expectedAt := date().setDay(4).Time
+	if !decoded.advanceX(t, 1).Equal(expectedAt) {
+		t.Error("Unexpected decoded Schedule date returned.")
+	}
+
+	sch = At(dt1, dt2)
+	sch.advanceX(t, 1)
+	data, _ = json.Marshal(sch)
+	decoded = &Schedule{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err.Error())
+	}
+	if !decoded.Following().Equal(dt1) || !decoded.advanceX(t, 1).Equal(dt2) {
+		t.Error("Unexpected decoded Schedule date returned.")
+	}
+
+	sch, _ = ParseSchedule("@every 1h")
+	sch.advanceX(t, 2)
+	data, _ = json.Marshal(sch)
+	decoded = &Schedule{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err.Error())
+	}
+	if !decoded.Following().Equal(sch.Following()) || !decoded.advanceX(t, 1).Equal(sch.advanceX(t, 1)) {
+		t.Error("Unexpected decoded Schedule date returned.")
+	}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err.Error())
+	}
+	daily, _ := Parse("0 9 * * *")
+	sch = At(time.Date(2026, time.March, 20, 12, 0, 0, 0, berlin))
+	sch.AddCron(daily)
+	sch.advanceX(t, 2)
+	data, _ = json.Marshal(sch)
+	decoded = &Schedule{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err.Error())
+	}
+	// Germany switches to daylight saving time on 2026-03-29.
+	for x := 0; x < 14; x++ {
+		if got := decoded.advanceX(t, 1); got.Location().String() != "Europe/Berlin" || got.Hour() != 9 {
+			t.Errorf("Expected the decoded Schedule to keep the location, got %v.", got)
+			break
+		}
+	}
+	if err := json.Unmarshal([]byte(`{"at":["2030-01-01T00:00:00Z"],"location":"Nowhere/Unknown","followingIndex":-1}`), decoded); err == nil {
+		t.Error("Expected an error for an unknown location.")
+	}
+
+	for _, data := range []string{
+		`{"followingIndex":3}`,
+		`{"following":"2030-01-01T00:00:00Z","followingIndex":0}`,
+		`{"at":["2030-01-01T00:00:00Z"],"following":"2030-01-02T00:00:00Z","followingIndex":1}`,
+		`{"at":["2030-01-01T00:00:00Z"],"followingIndex":1}`,
+		`{"cron":{"minutes":"0","hours":"0"},"followingIndex":0}`,
+	} {
+		if err := json.Unmarshal([]byte(data), decoded); err != InvalidScheduleError {
+			t.Errorf("Expected InvalidScheduleError for %s.", data)
+		}
+	}
+	for data, expected := range map[string]error{
+		`{"every":"-1m","followingIndex":5}`:                                         NoIntervalError,
+		`{"every":"0s","followingIndex":0}`:                                          NoIntervalError,
+		`{"at":["2030-01-01T00:00:00Z","2029-01-01T00:00:00Z"],"followingIndex":-1}`: TimeOrderError,
+		`{"at":["2030-01-01T00:00:00Z","2030-01-01T00:00:00Z"],"followingIndex":-1}`: TimeOrderError,
+	} {
+		if err := json.Unmarshal([]byte(data), decoded); err != expected {
+			t.Errorf("Expected %v for %s, got %v.", expected, data, err)
+		}
+	}
+}
+
+func TestCronExpression_Scan(t *testing.T) {
+	crn := &CronExpression{}
+	if err := crn.Scan("0 22 * * 1-5"); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt := date().setHour(22).Time
+	if crn.NewInstance(date().Time).advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected scanned CronExpression date returned.")
+	}
+	if err := crn.Scan([]byte("0 0 12 ? * 1")); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt = date().setHour(12).setDay(6).Time
+	if crn.NewInstance(date().Time).advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected scanned CronExpression date returned.")
+	}
+
+	if _, iOf := crn.Scan("0 25 * * *").(*ErrorParse); !iOf {
+		t.Error("Expected ErrorParse for invalid expression.")
+	}
+	if err := crn.Scan(nil); err != ScanError {
+		t.Error("Expected ScanError for NULL.")
+	} else if err.Error() != "schedule: CronExpression can only be scanned from text" {
+		t.Error("Unexpected ErrorScan message.")
+	}
+	if err := crn.Scan(42); err != ScanError {
+		t.Error("Expected ScanError for unsupported type.")
+	}
+}
+
+func TestCronExpression_Value(t *testing.T) {
+	value, err := Cron().OnHours(22).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)).Value()
+	if err != nil || value != "0 22 * * 1-5" {
+		t.Errorf("Unexpected CronExpression value %v.", value)
+	}
+	var crn *CronExpression
+	if value, err := crn.Value(); err != nil || value != nil {
+		t.Error("Expected NULL value for nil CronExpression.")
+	}
+	for _, crn := range []*CronExpression{
+		Cron().EveryDay().OnYearDays(100),
+		Cron().OnWeekdays(time.Friday).EveryWeeks(2, date().Time),
+		Cron().OnMilliseconds(500).EverySecond(),
+	} {
+		if value, err := crn.Value(); err != UnrepresentableError || value != nil {
+			t.Errorf("Expected UnrepresentableError, got %v.", value)
+		}
+	}
+}
+
+func TestCronBuilder_Build(t *testing.T) {
+	crn, err := NewCronBuilder().
+		OnMinutes(15).
+		OnHours(List([]int{9, 17})).
+		EveryDay().
+		OnWeekdays(1).
+		OnMonths(Between(1, 6)).
+		Build()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt := date().setHour(9).setMinute(15).setDay(7).Time
+	if crn.NewInstance(date().Time).advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	crn, err = NewCronBuilder().
+		EveryMillisecond().
+		EverySecond().
+		EveryMinute().
+		EveryHour().
+		EveryDay().
+		EveryMonth().
+		OnYears(2019).
+		Build()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt = date().setMillisecond(1).Time
+	if crn.NewInstance(date().Time).advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	if _, err = NewCronBuilder().Build(); err != NoFieldsError {
+		t.Error("Expected NoFieldsError.")
+	}
+	if _, err = NewCronBuilder().MatchDays(DaysOrWeekdays).Build(); err != NoFieldsError {
+		t.Error("Expected NoFieldsError.")
+	}
+
+	invalid := []struct {
+		b     *CronBuilder
+		field CronField
+		value int
+	}{
+		{NewCronBuilder().OnMilliseconds(1000), MillisecondField, 1000},
+		{NewCronBuilder().OnSeconds(Between(0, 60)), SecondField, 60},
+		{NewCronBuilder().OnMinutes(List([]int{-1, 5})), MinuteField, -1},
+		{NewCronBuilder().OnHours(24).OnMinutes(60), HourField, 24},
+		{NewCronBuilder().OnDays(0), DayField, 0},
+		{NewCronBuilder().OnWeekdays(time.Weekday(7)), WeekdayField, 7},
+		{NewCronBuilder().OnMonths(time.Month(13)), MonthField, 13},
+		{NewCronBuilder().OnYears(1969), YearField, 1969},
+	}
+	for _, c := range invalid {
+		_, err := c.b.Build()
+		valueErr, iOf := err.(*ErrorInvalidValue)
+		if !iOf || valueErr.Field != c.field || valueErr.Value != c.value {
+			t.Errorf("Unexpected CronBuilder error %v, expected %s %d.", err, c.field, c.value)
+		}
+	}
+	if _, err := NewCronBuilder().OnHours("12").Build(); err != UnsupportedExpressionError {
+		t.Error("Expected UnsupportedExpressionError.")
+	}
+	if _, err := NewCronBuilder().OnDays(32).Build(); err == nil || err.Error() != "schedule: invalid day value" {
+		t.Error("Unexpected ErrorInvalidValue message.")
+	}
+}
+
+func TestNewBetween(t *testing.T) {
+	exp, err := NewBetween(0, 10, 3)
+	if err != nil || !exp.Contains(3) || exp.Contains(4) {
+		t.Error("Unexpected NewBetween behavior.")
+	}
+	if _, err := NewBetween(1, 0, 1); err != InvalidBetweenError {
+		t.Error("Expected InvalidBetweenError.")
+	}
+	if _, err := NewBetween(0, 1, 0); err != InvalidStepError {
+		t.Error("Expected InvalidStepError.")
+	}
+}
+
+func TestNewList(t *testing.T) {
+	exp, err := NewList([]int{6, 3})
+	if err != nil || !exp.Contains(3) || exp.Contains(4) {
+		t.Error("Unexpected NewList behavior.")
+	}
+	if _, err := NewList(nil); err != InvalidListError {
+		t.Error("Expected InvalidListError.")
+	}
+}
+
+func TestNewAt(t *testing.T) {
+	if sch, err := NewAt(date().Time); err != nil || sch.advanceX(t, 1) != date().Time {
+		t.Error("Unexpected NewAt behavior.")
+	}
+	if _, err := NewAt(); err != NoTimesError {
+		t.Error("Expected NoTimesError.")
+	}
+	if _, err := NewAt(date().setDay(2).Time, date().Time); err != TimeOrderError {
+		t.Error("Expected TimeOrderError.")
+	}
+}
+
+func TestNewIn(t *testing.T) {
+	if _, err := NewIn(time.Hour); err != nil {
+		t.Error("Unexpected NewIn behavior.")
+	}
+	if _, err := NewIn(); err != NoDurationsError {
+		t.Error("Expected NoDurationsError.")
+	}
+}
+
+func TestNewAs(t *testing.T) {
+	if _, err := NewAs(Cron().EveryDay()); err != nil {
+		t.Error("Unexpected NewAs behavior.")
+	}
+	if _, err := NewAs(Cron().EveryDay().OnYears(time.Now().Year() - 1)); err != ExhaustedError {
+		t.Error("Expected ExhaustedError.")
+	}
+}
+
+func TestCronInstance_NextErrors(t *testing.T) {
+	crnI := Cron().EveryDay().NewInstance(time.Date(200000000, time.December, 31, 0, 0, 0, 0, time.UTC))
+	if err := crnI.Next(); err != YearOutOfRangeError || !errors.Is(err, CronOutdatedInvalidError) {
+		t.Errorf("Unexpected CronExpression error %v, expected YearOutOfRangeError.", err)
+	}
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+	crnI = Cron().OnMinutes(30).OnHours(2).NewInstance(time.Date(2019, time.March, 9, 12, 0, 0, 0, loc))
+	if err := crnI.Next(); err != DSTGapError || errors.Is(err, CronOutdatedInvalidError) {
+		t.Errorf("Unexpected CronExpression error %v, expected DSTGapError.", err)
+	}
+	if crnI.Following() != time.Date(2019, time.March, 9, 12, 0, 0, 0, loc) {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	if crnI.advanceX(t, 1) != time.Date(2019, time.March, 11, 2, 30, 0, 0, loc) {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	from := time.Date(2019, time.March, 10, 1, 50, 0, 0, loc)
+	crnI = Cron().OnMinutes(BetweenMinutes(0, 55).Every(5)).EveryHour().NewInstance(from)
+	gaps := 0
+	for err := crnI.Next(); err != nil || crnI.Following().Hour() < 3; err = crnI.Next() {
+		if err == DSTGapError {
+			gaps++
+		} else if err != nil {
+			t.Fatalf("Unexpected CronExpression error %v.", err)
+		}
+	}
+	if gaps != 12 || !crnI.Following().Equal(time.Date(2019, time.March, 10, 3, 0, 0, 0, loc)) {
+		t.Errorf("Expected 12 dates skipped before 03:00, got %d before %v.", gaps, crnI.Following())
+	}
+
+	sch := At(from)
+	sch.AddCron(Cron().OnMinutes(BetweenMinutes(0, 55).Every(5)).EveryHour())
+	if sch.advanceX(t, 2) != time.Date(2019, time.March, 10, 1, 55, 0, 0, loc) ||
+		sch.advanceX(t, 1) != time.Date(2019, time.March, 10, 3, 0, 0, 0, loc) {
+		t.Error("Expected the Schedule to pass over the dates skipped by the transition.")
+	}
+	if err := sch.Previous(); err != nil || sch.Following() != time.Date(2019, time.March, 10, 1, 55, 0, 0, loc) {
+		t.Errorf("Expected the Schedule to pass over the skipped dates backward, got %v (%v).", sch.Following(), err)
+	}
+}
+
+func TestLastDay(t *testing.T) {
+	crnI := Cron().OnDays(LastDay()).NewInstance(date().Time)
+	expectedAt := date().setDay(31).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setMonth(time.February).setDay(28).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	crnI = Cron().OnDays(LastDay().Before(3)).NewInstance(date().setYear(2020).setMonth(time.February).Time)
+	expectedAt = date().setYear(2020).setMonth(time.February).setDay(26).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	// days that do not exist in a month are skipped.
+	crnI = Cron().OnDays(LastDay().Before(30)).NewInstance(date().Time)
+	expectedAt = date().setMonth(time.March).setDay(1).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	crn, err := Parse("30 12 L-3 * *")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt = date().setHour(12).setMinute(30).setDay(28).Time
+	if crn.NewInstance(date().Time).advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	if crn.String() != "30 12 L-3 * *" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.String())
+	}
+	if crn.Describe() != "At 12:30 on the 3rd day before the last day of the month." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.Describe())
+	}
+	descriptions := []struct {
+		crn      *CronExpression
+		loc      Locale
+		expected string
+	}{
+		{crn, German, "Um 12:30 am 3. Tag vor dem letzten Tag des Monats."},
+		{crn, French, "À 12:30 le 3e jour avant le dernier jour du mois."},
+		{crn, Portuguese, "Às 12:30 no 3.º dia antes do último dia do mês."},
+		{Cron().OnDays(LastDay().Before(1)), German, "Um 00:00 am vorletzten Tag des Monats."},
+		{Cron().OnDays(LastDay().Before(1)), French, "À 00:00 l'avant-dernier jour du mois."},
+		{Cron().OnDays(LastDay().Before(1)), Portuguese, "Às 00:00 no penúltimo dia do mês."},
+	}
+	for _, c := range descriptions {
+		if c.crn.DescribeIn(c.loc) != c.expected {
+			t.Errorf("Unexpected CronExpression description %q, expected %q.", c.crn.DescribeIn(c.loc), c.expected)
+		}
+	}
+	if crn, _ := ParseQuartz("0 0 0 L * ?"); crn.Format(QuartzFormat) != "0 0 0 L * ? *" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.Format(QuartzFormat))
+	}
+
+	for _, spec := range []string{"0 0 L- * *", "0 0 L-31 * *", "0 0 LX * *", "0 0 1,L * *"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Expected error parsing %q.", spec)
+		}
+	}
+	if _, err := NewCronBuilder().OnHours(LastDay()).Build(); err != UnsupportedExpressionError {
+		t.Error("Expected UnsupportedExpressionError.")
+	}
+	defer func() {
+		if r := recover(); r == nil || r != "schedule: invalid offset value" {
+			t.Error("Unexpected LastDayExpression behavior.")
+		}
+	}()
+	LastDay().Before(31)
+}
+
+func TestNthWeekday(t *testing.T) {
+	crnI := Cron().EveryDay().OnWeekdays(NthWeekday(time.Tuesday, 2)).NewInstance(date().Time)
+	expectedAt := date().setDay(8).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setMonth(time.February).setDay(12).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	crnI = Cron().OnDays(LastWeekday(time.Friday)).NewInstance(date().Time)
+	expectedAt = date().setDay(25).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setMonth(time.February).setDay(22).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	// months without a fifth occurrence are skipped.
+	crnI = Cron().EveryDay().OnWeekdays(NthWeekday(time.Monday, 5)).NewInstance(date().Time)
+	expectedAt = date().setMonth(time.April).setDay(29).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	crn, err := ParseQuartz("0 0 9 ? * 3#2")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt = date().setHour(9).setDay(8).Time
+	if crn.NewInstance(date().Time).advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	if crn.Format(QuartzFormat) != "0 0 9 ? * 3#2 *" || crn.String() != "0 9 * * 2#2" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.Format(QuartzFormat))
+	}
+	if crn.Describe() != "At 09:00 on the second Tuesday of the month." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.Describe())
+	}
+	crn, err = Parse("0 18 * * 5L")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt = date().setHour(18).setDay(25).Time
+	if crn.NewInstance(date().Time).advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	if crn.String() != "0 18 * * 5L" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.String())
+	}
+	if crn.DescribeIn(German) != "Um 18:00 am letzten Freitag des Monats." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.DescribeIn(German))
+	}
+	if crn.DescribeIn(Portuguese) != "Às 18:00 na última sexta-feira do mês." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.DescribeIn(Portuguese))
+	}
+
+	for _, spec := range []string{"0 0 * * 1#0", "0 0 * * 1#6", "0 0 * * 8#1", "0 0 * * L", "0 0 * * 1#x"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Expected error parsing %q.", spec)
+		}
+	}
+	if _, err := Parse("0 0 * * L"); err == nil || err.Error() != `schedule: invalid weekday field at position 8: missing weekday before "L"` {
+		t.Errorf("Unexpected error %v.", err)
+	}
+	crn, err = ParseQuartz("0 0 9 ? * L")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// 2019-01-05 is the first Saturday of 2019.
+	if crn.NewInstance(date().Time).advanceX(t, 1) != date().setDay(5).setHour(9).Time {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	defer func() {
+		if r := recover(); r == nil || r != "schedule: invalid occurrence value" {
+			t.Error("Unexpected NthWeekdayExpression behavior.")
+		}
+	}()
+	NthWeekday(time.Monday, 6)
+}
+
+func TestNearestWeekday(t *testing.T) {
+	cases := []struct {
+		exp      *NearestWeekdayExpression
+		from     time.Month
+		expected time.Time
+	}{
+		{NearestWeekday(15), time.January, date().setDay(15).Time},
+		{NearestWeekday(15), time.September, date().setMonth(time.September).setDay(16).Time},
+		{NearestWeekday(15), time.June, date().setMonth(time.June).setDay(14).Time},
+		{NearestWeekday(1), time.June, date().setMonth(time.June).setDay(3).Time},
+		{NearestWeekday(31), time.April, date().setMonth(time.May).setDay(31).Time},
+		{NearestWeekdayToLastDay(), time.August, date().setMonth(time.August).setDay(30).Time},
+		{NearestWeekdayToLastDay(), time.March, date().setMonth(time.March).setDay(29).Time},
+	}
+	for _, c := range cases {
+		crnI := Cron().OnDays(c.exp).NewInstance(date().setMonth(c.from).Time)
+		if crnI.advanceX(t, 1) != c.expected {
+			t.Errorf("Unexpected CronExpression date %v, expected %v.", crnI.Following(), c.expected)
+		}
+	}
+
+	crn, err := ParseQuartz("0 0 8 LW * ?")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if crn.String() != "0 8 LW * *" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.String())
+	}
+	if crn.Describe() != "At 08:00 on the last weekday of the month." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.Describe())
+	}
+	crn, err = Parse("0 8 15W * *")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if crn.String() != "0 8 15W * *" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.String())
+	}
+	if crn.DescribeIn(French) != "À 08:00 le jour ouvré le plus proche du 15 du mois." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.DescribeIn(French))
+	}
+	if crn.DescribeIn(German) != "Um 08:00 am Werktag, der dem 15. Tag des Monats am nächsten liegt." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.DescribeIn(German))
+	}
+
+	for _, spec := range []string{"0 0 W * *", "0 0 32W * *", "0 0 L-3W * *", "0 0 1,15W * *"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Expected error parsing %q.", spec)
+		}
+	}
+}
+
+func TestCronExpression_MatchDays(t *testing.T) {
+	crn, err := Parse("0 0 1,15 * 3")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	crnI := crn.NewInstance(date().Time)
+	for _, d := range []int{2, 9, 15, 16, 23, 30} {
+		if crnI.advanceX(t, 1) != date().setDay(d).Time {
+			t.Errorf("Unexpected CronExpression date %v, expected day %d.", crnI.Following(), d)
+		}
+	}
+	expectedAt := date().setMonth(time.February).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	if crn.Describe() != "At 00:00 on day-of-month 1 and 15 or on Wednesday." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.Describe())
+	}
+
+	// unrestricted weekdays keep the days as the only condition.
+	crn, err = Parse("0 0 15 * *")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt = date().setMonth(time.February).setDay(15).Time
+	if crn.NewInstance(date().Time).advanceX(t, 2) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	// the fluent API combines both by default.
+	crnI = Cron().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday).NewInstance(date().Time)
+	expectedAt = date().setMonth(time.May).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	data, err := json.Marshal(Cron().OnDays(LastDay()).OnWeekdays(time.Monday).MatchDays(DaysOrWeekdays))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	decoded := Cron()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt = date().setDay(7).Time
+	if decoded.NewInstance(date().Time).advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setDay(31).Time
+	if decoded.NewInstance(date().Time).advanceX(t, 5) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	// as in Vixie cron, the combination depends on the fields starting with "*" rather than on their values.
+	from := time.Date(2026, time.May, 31, 12, 0, 0, 0, time.UTC)
+	for text, days := range map[string][]int{
+		"0 0 */2 * 1":  {1, 15, 29},
+		"0 0 1-31 * 1": {1, 2, 3},
+		"0 0 1 * 0-7":  {1, 2, 3},
+	} {
+		crn, err := Parse(text)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		crnI := crn.NewInstance(from)
+		for _, d := range days {
+			if got := crnI.advanceX(t, 1); got != time.Date(2026, time.June, d, 0, 0, 0, 0, time.UTC) {
+				t.Errorf("Unexpected %q date %v, expected day %d.", text, got, d)
+			}
+		}
+	}
+	crn, _ = Parse("0 0 */2 * 1")
+	if crn.String() != "0 0 */2 * 1" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.String())
+	}
+	crn = Cron().OnDays(BetweenDays(1, 31).Every(2)).OnWeekdays(time.Monday).MatchDays(DaysOrWeekdays)
+	if crn.String() != "0 0 1-31/2 * 1" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.String())
+	}
+	if parsed, _ := Parse(crn.String()); parsed.NewInstance(from).advanceX(t, 3) != time.Date(2026, time.June, 5, 0, 0, 0, 0, time.UTC) {
+		t.Error("Expected the days and weekdays to be combined with DaysOrWeekdays.")
+	}
+}
+
+func TestCyclicExpression(t *testing.T) {
+	exp := CyclicHours(22, 2)
+	nexts := []struct {
+		from     int
+		inc      bool
+		expected int
+		last     bool
+	}{
+		{0, true, 0, false},
+		{0, false, 1, false},
+		{2, false, 22, false},
+		{3, true, 22, false},
+		{22, false, 23, true},
+		{23, false, 23, true},
+	}
+	for _, n := range nexts {
+		if v, last := exp.Next(n.from, n.inc); v != n.expected || last != n.last {
+			t.Errorf("Unexpected CyclicExpression Next(%d, %t) = %d, %t.", n.from, n.inc, v, last)
+		}
+	}
+	if !exp.Contains(23) || !exp.Contains(0) || exp.Contains(3) || exp.Contains(21) {
+		t.Error("Unexpected CyclicExpression Contains behavior.")
+	}
+	exp = CyclicHours(22, 3).Every(2)
+	if !exp.Contains(22) || !exp.Contains(0) || !exp.Contains(2) || exp.Contains(23) || exp.Contains(3) {
+		t.Error("Unexpected CyclicExpression Contains behavior.")
+	}
+
+	crnI := Cron().EveryDay().OnWeekdays(CyclicWeekdays(time.Friday, time.Monday)).NewInstance(date().Time)
+	for _, d := range []int{4, 5, 6, 7, 11} {
+		if crnI.advanceX(t, 1) != date().setDay(d).Time {
+			t.Errorf("Unexpected CronExpression date %v, expected day %d.", crnI.Following(), d)
+		}
+	}
+	crnI = Cron().OnMonths(CyclicMonths(time.November, time.February)).NewInstance(date().Time)
+	for _, mon := range []time.Month{time.February, time.November, time.December} {
+		if crnI.advanceX(t, 1) != date().setMonth(mon).Time {
+			t.Errorf("Unexpected CronExpression date %v, expected month %s.", crnI.Following(), mon)
+		}
+	}
+
+	crn, err := Parse("0 22-2/2 * 11-2 5-1")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	crnI = crn.NewInstance(date().Time)
+	for _, h := range []int{0, 2, 22} {
+		if crnI.advanceX(t, 1) != date().setDay(4).setHour(h).Time {
+			t.Errorf("Unexpected CronExpression date %v, expected hour %d.", crnI.Following(), h)
+		}
+	}
+	if crn.String() != "0 22-2/2 * 11-2 5-1" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.String())
+	}
+	if crn.Describe() != "At minute 0 past every 2nd hour from 22 through 2 on every day-of-week from Friday through Monday in every month from November through February." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.Describe())
+	}
+	crn, err = Parse("0 22-1,12 * * *")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if crn.NewInstance(date().Time).advanceX(t, 1) != date().setHour(1).Time {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	if _, err := Parse("0 0 20-10 * *"); err == nil {
+		t.Error("Expected error parsing a wrapping day-of-month range.")
+	}
+}
+
+func TestCompositeExpressions(t *testing.T) {
+	exps := []struct {
+		exp      IteratorExpression
+		expected []int
+	}{
+		{Union(Between(0, 6), 12), []int{0, 1, 2, 3, 4, 5, 6, 12}},
+		{Union(ListHours(12, 18), Between(10, 14).Every(2)), []int{10, 12, 14, 18}},
+		{Intersect(Between(0, 30).Every(5), Between(20, 59)), []int{20, 25, 30}},
+		{Intersect(ListMinutes(1, 2), ListMinutes(3, 4)), nil},
+		{Except(Between(0, 55).Every(5), 0, 30), []int{5, 10, 15, 20, 25, 35, 40, 45, 50, 55}},
+		{Except(Union(Between(0, 6), 12), Intersect(Between(0, 10), Between(4, 20))), []int{0, 1, 2, 3, 12}},
+	}
+	for _, c := range exps {
+		values := field{unit: minuteUnit}.enumerate(c.exp)
+		if len(values) != len(c.expected) {
+			t.Errorf("Unexpected expression values %v, expected %v.", values, c.expected)
+			continue
+		}
+		for i, v := range values {
+			if v != c.expected[i] || !c.exp.Contains(v) {
+				t.Errorf("Unexpected expression values %v, expected %v.", values, c.expected)
+				break
+			}
+		}
+	}
+	crnI := Cron().OnMinutes(Intersect(ListMinutes(1, 2), ListMinutes(3, 4))).NewInstance(date().Time)
+	if err := crnI.Next(); err != UnsatisfiableError {
+		t.Errorf("Expected UnsatisfiableError for an empty combination, got %v at %v.", err, crnI.Following())
+	}
+	if err := crnI.Previous(); err != UnsatisfiableError {
+		t.Errorf("Expected UnsatisfiableError for an empty combination, got %v at %v.", err, crnI.Following())
+	}
+	if v, last := Union(1, 5).Next(5, false); v != 5 || !last {
+		t.Error("Unexpected UnionExpression Next behavior.")
+	}
+	if v, last := Intersect(Between(0, 30).Every(5), Between(12, 59)).Next(40, true); v != 30 || !last {
+		t.Error("Unexpected IntersectExpression Next behavior.")
+	}
+	if v, last := Except(Between(0, 10), 10, 9).Next(10, true); v != 8 || !last {
+		t.Error("Unexpected ExceptExpression Next behavior.")
+	}
+	crnI = Cron().OnYears(2030).OnMonths(Union(time.January)).NewInstance(date().Time)
+	if crnI.advanceX(t, 1) != time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC) {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	if v, last := Except(Between(0, 10), 10).Next(8, false); v != 9 || !last {
+		t.Error("Unexpected ExceptExpression Next behavior.")
+	}
+
+	crn := Cron().OnMinutes(Except(Between(0, 55).Every(5), 0, 30)).OnHours(Union(Between(0, 6), 12))
+	crnI = crn.NewInstance(date().Time)
+	expectedAt := date().setMinute(5).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setHour(1).setMinute(5).Time
+	if crnI.advanceX(t, 10) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setHour(12).setMinute(5).Time
+	if crnI.advanceX(t, 60) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	if crn.String() != "5,10,15,20,25,35,40,45,50,55 0,1,2,3,4,5,6,12 * * *" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.String())
+	}
+	crnI = Cron().EveryDay().OnWeekdays(Except(BetweenWeekdays(time.Monday, time.Friday), time.Wednesday)).NewInstance(date().Time)
+	for _, d := range []int{3, 4, 7, 8, 10} {
+		if crnI.advanceX(t, 1) != date().setDay(d).Time {
+			t.Errorf("Unexpected CronExpression date %v, expected day %d.", crnI.Following(), d)
+		}
+	}
+
+	if _, err := NewCronBuilder().OnMinutes(Union(Between(0, 10), 60)).Build(); err == nil {
+		t.Error("Expected ErrorInvalidValue.")
+	}
+	defer func() {
+		if r := recover(); r == nil || r != "schedule: unsupported expression type" {
+			t.Error("Unexpected UnionExpression behavior.")
+		}
+	}()
+	Union("0")
+}
+
+func TestParseNames(t *testing.T) {
+	crons := []struct {
+		spec     string
+		expected string
+	}{
+		{"0 0 * JAN-MAR MON,wed,Fri", "0 0 * 1-3 1,3,5"},
+		{"0 0 * january,December sunday-TUESDAY", "0 0 * 1,12 0-2"},
+		{"0 0 * NOV-FEB *", "0 0 * 11-2 *"},
+		{"0 0 * * 7", "0 0 * * 0"},
+		{"0 0 * * 5-7", "0 0 * * 5-0"},
+		{"0 0 * * 0-7", "0 0 * * *"},
+		{"0 0 * * SUN-7", "0 0 * * 0"},
+		{"0 0 * * 7-7", "0 0 * * 0"},
+		{"0 0 * * MON#2", "0 0 * * 1#2"},
+		{"0 0 * * FRIL", "0 0 * * 5L"},
+	}
+	for _, c := range crons {
+		crn, err := Parse(c.spec)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %s.", c.spec, err.Error())
+			continue
+		}
+		if crn.String() != c.expected {
+			t.Errorf("Unexpected CronExpression string %q, expected %q.", crn.String(), c.expected)
+		}
+	}
+
+	crn, err := ParseQuartz("0 0 12 ? jul-AUG MON-FRI")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if crn.String() != "0 12 * 7-8 1-5" {
+		t.Errorf("Unexpected CronExpression string %q.", crn.String())
+	}
+	crnI := Cron().OnWeekdays(ListWeekdays(time.Sunday, time.Monday)).EveryDay().NewInstance(date().Time)
+	crn, _ = Parse("0 0 * * 7,1")
+	for x := 0; x < 4; x++ {
+		if crn.NewInstance(date().Time).advanceX(t, x+1) != crnI.advanceX(t, 1) {
+			t.Error("Unexpected CronExpression date returned.")
+		}
+	}
+
+	for _, spec := range []string{"0 0 * JA * ", "0 0 * * MONDAYS", "0 0 * * 8", "0 0 MON * *", "0 JAN * * *"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Expected error parsing %q.", spec)
+		}
+	}
+	if _, err := ParseQuartz("0 0 0 ? * 0"); err == nil {
+		t.Error("Expected error parsing a Quartz day-of-week 0.")
+	}
+}
+
+func TestAttunedMonth_YearDayISOWeek(t *testing.T) {
+	for at := date().setYear(2015).Time; at.Year() < 2030; at = at.AddDate(0, 0, 1) {
+		am := NewAttunedMonth(int(at.Month()), at.Year())
+		_, week := at.ISOWeek()
+		if am.YearDay(at.Day()) != at.YearDay() || am.ISOWeek(at.Day()) != week {
+			t.Fatalf("Unexpected AttunedMonth day of the year %d or ISO week %d for %v.", am.YearDay(at.Day()), am.ISOWeek(at.Day()), at)
+		}
+	}
+}
+
+func TestCronExpression_OnYearDays(t *testing.T) {
+	crnI := Cron().OnYearDays(ListYearDays(1, 100, 366)).NewInstance(date().Time)
+	expectedAt := date().setMonth(time.April).setDay(10).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setYear(2020).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setYear(2020).setMonth(time.April).setDay(9).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	expectedAt = date().setYear(2020).setMonth(time.December).setDay(31).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	// quarterly close, on the last day of every quarter.
+	crnI = Cron().OnYearDays(BetweenYearDays(80, 100)).OnDays(LastDay()).NewInstance(date().Time)
+	expectedAt = date().setMonth(time.March).setDay(31).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+
+	if _, err := NewCronBuilder().OnYearDays(367).Build(); err == nil || err.Error() != "schedule: invalid year day value" {
+		t.Error("Expected ErrorInvalidValue.")
+	}
+}
+
+func TestCronExpression_OnISOWeeks(t *testing.T) {
+	crn := Cron().OnISOWeeks(ListISOWeeks(1, 27)).OnWeekdays(time.Monday).EveryDay()
+	crnI := crn.NewInstance(date().Time)
+	expectedAt := date().setMonth(time.July).setDay(1).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	// the first ISO week of 2020 starts in 2019.
+	expectedAt = date().setMonth(time.December).setDay(30).Time
+	if crnI.advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	if crn.Describe() != "At 00:00 on Monday in ISO week 1 and 27." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.Describe())
+	}
+	if crn.DescribeIn(German) != "Um 00:00 am Montag in der Kalenderwoche 1 und 27." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.DescribeIn(German))
+	}
+
+	data, err := json.Marshal(Cron().OnISOWeeks(BetweenISOWeeks(1, 26).Every(2)).OnYearDays(100))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if string(data) != `{"milliseconds":"0","seconds":"0","minutes":"0","hours":"0","yearDays":"100","isoWeeks":"1-25/2,26"}` {
+		t.Errorf("Unexpected CronExpression JSON %s.", data)
+	}
+	decoded := Cron()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectedAt = date().setMonth(time.April).setDay(10).Time
+	if decoded.NewInstance(date().Time).advanceX(t, 1) != expectedAt {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+}
+
+func TestCronExpression_EveryWeeks(t *testing.T) {
+	anchor := time.Date(2026, time.January, 9, 0, 0, 0, 0, time.UTC)
+	crn := Cron().EveryDay().OnWeekdays(time.Friday).EveryWeeks(2, anchor)
+	crnI := crn.NewInstance(date().Time)
+	for _, expected := range []time.Time{
+		anchor,
+		anchor.AddDate(0, 0, 14),
+		anchor.AddDate(0, 0, 28),
+	} {
+		if crnI.advanceX(t, 1) != expected {
+			t.Errorf("Unexpected CronExpression date %v, expected %v.", crnI.Following(), expected)
+		}
+	}
+	if crn.Describe() != "At 00:00 on Friday every 2nd week starting 2026-01-09." {
+		t.Errorf("Unexpected CronExpression description %q.", crn.Describe())
+	}
+
+	// the weeks are counted from the anchor date, regardless of their weekday.
+	crnI = Cron().EveryDay().OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)).EveryWeeks(3, date().setDay(2).Time).NewInstance(date().Time)
+	for _, d := range []int{2, 3, 4, 7, 8, 23} {
+		if crnI.advanceX(t, 1) != date().setDay(d).Time {
+			t.Errorf("Unexpected CronExpression date %v, expected day %d.", crnI.Following(), d)
+		}
+	}
+
+	data, err := json.Marshal(crn)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	decoded := Cron()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err.Error())
+	}
+	if decoded.NewInstance(anchor).advanceX(t, 1) != anchor.AddDate(0, 0, 14) {
+		t.Error("Unexpected CronExpression date returned.")
+	}
+	if err := json.Unmarshal([]byte(`{"weeks":{"every":0,"anchor":"2026-01-09"}}`), decoded); err != InvalidStepError {
+		t.Error("Expected InvalidStepError.")
+	}
+	if _, err := NewCronBuilder().EveryWeeks(0, anchor).Build(); err != InvalidStepError {
+		t.Error("Expected InvalidStepError.")
+	}
+}
+
+func TestEvery(t *testing.T) {
+	anchor := time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)
+	sch := Every(7*time.Minute, anchor)
+	if !sch.advanceX(t, 1).Equal(anchor) || !sch.advanceX(t, 8).Equal(anchor.Add(56*time.Minute)) {
+		t.Error("Unexpected Every date returned.")
+	}
+	if !sch.advanceX(t, 1).Equal(time.Date(2100, time.January, 1, 1, 3, 0, 0, time.UTC)) {
+		t.Error("Expected Every to keep its spacing across the hour boundary.")
+	}
+
+	past := time.Now().Add(-90 * time.Minute)
+	if got := Every(time.Hour, past).advanceX(t, 1); !got.Equal(past.Add(2 * time.Hour)) {
+		t.Errorf("Expected the first date after now, got %v.", got)
+	}
+
+	for _, distant := range []time.Time{{}, time.Date(1700, time.March, 1, 0, 0, 0, 0, time.UTC)} {
+		got := Every(7*time.Minute, distant).advanceX(t, 1)
+		if !got.After(time.Now()) || got.After(time.Now().Add(7*time.Minute)) || (got.Unix()-distant.Unix())%(7*60) != 0 {
+			t.Errorf("Expected the first date after now aligned on %v, got %v.", distant, got)
+		}
+	}
+
+	if _, err := NewEvery(0, anchor); err != NoIntervalError {
+		t.Error("Expected NoIntervalError.")
+	}
+}
+
+func TestSchedule_AddWindow(t *testing.T) {
+	// 2100-01-01 is a Friday.
+	anchor := time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)
+	sch := Every(7*time.Minute, anchor)
+	sch.AddWindow(Cron().EveryMillisecond().EverySecond().EveryMinute().
+		OnHours(BetweenHours(9, 16)).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)))
+	if got := sch.advanceX(t, 1); !got.Equal(time.Date(2100, time.January, 1, 9, 6, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected first date %v.", got)
+	}
+	if got := sch.advanceX(t, 67); !got.Equal(time.Date(2100, time.January, 1, 16, 55, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected last date of the window %v.", got)
+	}
+	if got := sch.advanceX(t, 1); !got.Equal(time.Date(2100, time.January, 4, 9, 5, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected first date of the following window %v.", got)
+	}
+
+	data, _ := json.Marshal(sch)
+	decoded := &Schedule{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err.Error())
+	}
+	if !decoded.advanceX(t, 1).Equal(sch.advanceX(t, 1)) {
+		t.Error("Unexpected decoded Schedule date returned.")
+	}
+
+	sch = Every(time.Hour, anchor)
+	sch.AddWindow(Cron().EveryMillisecond().EverySecond().OnMinutes(BetweenMinutes(30, 31)))
+	if err := sch.Next(); err != UnsatisfiableError {
+		t.Error("Expected UnsatisfiableError.")
+	}
+}
+
+func TestSchedule_AddWindowPrecision(t *testing.T) {
+	// 2100-01-01 is a Friday, the anchor is not on a whole minute.
+	anchor := time.Date(2100, time.January, 1, 0, 0, 30, 0, time.UTC)
+	window, err := Parse("* 9-16 * * 1-5")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	sch := Every(7*time.Minute, anchor)
+	sch.AddWindow(window)
+	if got := sch.advanceX(t, 1); !got.Equal(time.Date(2100, time.January, 1, 9, 6, 30, 0, time.UTC)) {
+		t.Errorf("Unexpected first date %v.", got)
+	}
+	if got := sch.advanceX(t, 67); !got.Equal(time.Date(2100, time.January, 1, 16, 55, 30, 0, time.UTC)) {
+		t.Errorf("Unexpected last date of the window %v.", got)
+	}
+	if got := sch.advanceX(t, 1); !got.Equal(time.Date(2100, time.January, 4, 9, 5, 30, 0, time.UTC)) {
+		t.Errorf("Unexpected first date of the following window %v.", got)
+	}
+	if err := sch.Previous(); err != nil || !sch.Following().Equal(time.Date(2100, time.January, 1, 16, 55, 30, 0, time.UTC)) {
+		t.Errorf("Unexpected previous date %v (%v).", sch.Following(), err)
+	}
+
+	window, _ = Parse("*/15 * * * *")
+	sch = Every(5*time.Minute, anchor)
+	sch.AddWindow(window)
+	for _, expected := range []int{0, 15, 30, 45, 60} {
+		if got := sch.advanceX(t, 1); !got.Equal(anchor.Add(time.Duration(expected) * time.Minute)) {
+			t.Errorf("Expected the minutes of the window to be matched, got %v.", got)
+		}
+	}
+
+	for _, text := range []string{"0 9 * * *", "30 9 * * *"} {
+		window, _ = Parse(text)
+		sch = Every(time.Minute, anchor)
+		sch.AddWindow(window)
+		opening := time.Date(2100, time.January, 1, 9, window.minutes.(int), 30, 0, time.UTC)
+		if got := sch.advanceX(t, 1); !got.Equal(opening) {
+			t.Errorf("Unexpected first date %v for %q.", got, text)
+		}
+		if got := sch.advanceX(t, 1); !got.Equal(opening.AddDate(0, 0, 1)) {
+			t.Errorf("Expected %q to open a single minute, got %v.", text, got)
+		}
+	}
+}
+
+func TestCronInstance_Previous(t *testing.T) {
+	from := time.Date(2024, time.February, 20, 10, 17, 3, 0, time.UTC)
+	crns := []*CronExpression{
+		Cron().EveryMillisecond().OnSeconds(ListSeconds(0, 30)).OnMinutes(ListMinutes(5, 6)),
+		Cron().EveryMinute().OnHours(BetweenHours(9, 17)).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)),
+		Cron().OnMinutes(BetweenMinutes(0, 55).Every(7)).OnHours(CyclicHours(22, 2)),
+		Cron().OnDays(29).OnMonths(time.February),
+		Cron().OnDays(LastDay().Before(2)).OnHours(12),
+		Cron().OnWeekdays(NthWeekday(time.Friday, 3)),
+		Cron().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday).MatchDays(DaysOrWeekdays),
+		Cron().OnYearDays(ListYearDays(1, 100, 366)),
+		Cron().EveryDay().OnMonths(time.July).OnYears(ListYears(2018, 2021)),
+	}
+	for i, crn := range crns {
+		crnI := crn.NewInstance(from)
+		var dates []time.Time
+		for x := 0; x < 30; x++ {
+			if err := crnI.Next(); err != nil {
+				break
+			}
+			dates = append(dates, crnI.Following())
+		}
+		for x := len(dates) - 2; x >= 0; x-- {
+			if err := crnI.Previous(); err != nil || !crnI.Following().Equal(dates[x]) {
+				t.Fatalf("Expression %d: expected %v, got %v (%v).", i, dates[x], crnI.Following(), err)
+			}
+		}
+	}
+
+	crnI := Cron().EveryDay().OnMonths(time.July).OnYears(ListYears(2018, 2021)).NewInstance(from)
+	if err := crnI.Previous(); err != nil || !crnI.Following().Equal(time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected previous date %v (%v).", crnI.Following(), err)
+	}
+	crnI = Cron().EveryDay().OnYears(2018).NewInstance(time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err := crnI.Previous(); err != ExhaustedError {
+		t.Error("Expected ExhaustedError.")
+	}
+	if !crnI.advanceX(t, 1).Equal(time.Date(2018, time.January, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Expected the instance to be left unchanged.")
+	}
+	never, _ := Parse("0 0 31 2 *")
+	for _, at := range []time.Time{from, time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)} {
+		crnI = never.NewInstance(at)
+		if err := crnI.Previous(); err != UnsatisfiableError {
+			t.Errorf("Expected UnsatisfiableError from %v, got %v.", at, err)
+		}
+		if err := crnI.Next(); err != UnsatisfiableError {
+			t.Errorf("Expected UnsatisfiableError from %v, got %v.", at, err)
+		}
+	}
+}
+
+func TestSchedule_Previous(t *testing.T) {
+	dt1, dt2 := date().setDay(2).Time, date().setDay(3).Time
+	sch := At(dt1, dt2)
+	sch.AddCron(Cron().EveryDay())
+	sch.advanceX(t, 4)
+	for _, expected := range []time.Time{date().setDay(4).Time, dt2, dt1} {
+		if err := sch.Previous(); err != nil || !sch.Following().Equal(expected) {
+			t.Errorf("Expected %v, got %v (%v).", expected, sch.Following(), err)
+		}
+	}
+	if err := sch.Previous(); err != ExhaustedError {
+		t.Error("Expected ExhaustedError.")
+	}
+	if !sch.advanceX(t, 2).Equal(date().setDay(4).Time) {
+		t.Error("Unexpected Schedule date returned.")
+	}
+
+	anchor := time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)
+	sch = Every(7*time.Minute, anchor)
+	if err := sch.Previous(); err != nil || !sch.Following().Equal(anchor.Add(-7*time.Minute)) {
+		t.Errorf("Unexpected previous interval date %v (%v).", sch.Following(), err)
+	}
+	if !sch.advanceX(t, 2).Equal(anchor.Add(7 * time.Minute)) {
+		t.Error("Unexpected Schedule date returned.")
+	}
+
+	sch = Every(7*time.Minute, anchor)
+	sch.AddWindow(Cron().EveryMillisecond().EverySecond().EveryMinute().OnHours(BetweenHours(9, 16)))
+	sch.advanceX(t, 1)
+	if err := sch.Previous(); err != nil || !sch.Following().Equal(time.Date(2099, time.December, 31, 16, 53, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected previous window date %v (%v).", sch.Following(), err)
+	}
+}
+
+func TestCronExpression_Matches(t *testing.T) {
+	from := time.Date(2024, time.February, 20, 10, 17, 3, 0, time.UTC)
+	crns := []*CronExpression{
+		Cron().EveryMinute().OnHours(BetweenHours(9, 17)).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)),
+		Cron().OnDays(29).OnMonths(time.February),
+		Cron().OnDays(LastDay().Before(2)).OnHours(12),
+		Cron().OnWeekdays(NthWeekday(time.Friday, 3)),
+		Cron().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday).MatchDays(DaysOrWeekdays),
+		Cron().EveryDay().OnISOWeeks(1).EveryWeeks(2, from),
+	}
+	for i, crn := range crns {
+		crnI := crn.NewInstance(from)
+		for x := 0; x < 20; x++ {
+			if err := crnI.Next(); err != nil {
+				t.Fatal(err.Error())
+			}
+			following := crnI.Following()
+			if !crn.Matches(following) {
+				t.Errorf("Expression %d: expected %v to match.", i, following)
+			}
+			if crn.Matches(following.Add(time.Millisecond)) || crn.Matches(following.Add(-time.Millisecond)) {
+				t.Errorf("Expression %d: expected the dates around %v not to match.", i, following)
+			}
+		}
+	}
+
+	crn := Cron().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday)
+	if crn.Matches(time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)) != true ||
+		crn.Matches(time.Date(2024, time.May, 8, 0, 0, 0, 0, time.UTC)) != false {
+		t.Error("Unexpected DaysAndWeekdays match.")
+	}
+	crn.MatchDays(DaysOrWeekdays)
+	if !crn.Matches(time.Date(2024, time.May, 8, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Unexpected DaysOrWeekdays match.")
+	}
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+	crn = Cron().EveryDay().OnHours(9)
+	nine := time.Date(2024, time.May, 1, 9, 0, 0, 0, loc)
+	if !crn.Matches(nine) || crn.Matches(nine.UTC()) {
+		t.Error("Expected the date to be matched in its own location.")
+	}
+}
+
+func TestCronExpression_Between(t *testing.T) {
+	from := time.Date(2024, time.February, 26, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	crn := Cron().OnMinutes(ListMinutes(0, 30)).OnHours(BetweenHours(9, 10)).
+		OnWeekdays(BetweenWeekdays(time.Monday, time.Friday))
+	dates := crn.Between(from, to, 100)
+	if len(dates) != 20 || !dates[0].Equal(from.Add(9*time.Hour)) ||
+		!dates[19].Equal(time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected dates returned %v.", dates)
+	}
+	if dates = crn.Between(from.Add(9*time.Hour), to, 3); len(dates) != 3 || !dates[0].Equal(from.Add(9*time.Hour)) {
+		t.Errorf("Expected the limit and the inclusive from to be respected, got %v.", dates)
+	}
+	if dates = crn.Between(to, from, 100); len(dates) != 0 {
+		t.Error("Expected no dates for an empty range.")
+	}
+}
+
+func TestCronExpression_Count(t *testing.T) {
+	from := time.Date(2024, time.February, 26, 9, 15, 0, 500, time.UTC)
+	crns := []*CronExpression{
+		Cron().OnMinutes(ListMinutes(0, 30)).OnHours(BetweenHours(9, 10)).
+			OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)),
+		Cron().EverySecond().OnMinutes(BetweenMinutes(0, 55).Every(7)).OnHours(CyclicHours(22, 2)),
+		Cron().OnMilliseconds(ListMilliseconds(0, 500)).EverySecond().OnMinutes(15).OnHours(9),
+		Cron().OnDays(ListDays(1, 15)).OnWeekdays(time.Wednesday).MatchDays(DaysOrWeekdays),
+		Cron().OnDays(29).OnMonths(time.February),
+	}
+	ends := []time.Time{from, from.Add(time.Millisecond), from.Add(36 * time.Hour), from.AddDate(0, 1, 3)}
+	for i, crn := range crns {
+		for _, to := range ends {
+			expected := len(crn.Between(from, to, math.MaxInt32))
+			if count := crn.Count(from, to); count != expected {
+				t.Errorf("Expression %d until %v: expected %d, got %d.", i, to, expected, count)
+			}
+		}
+	}
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+	crn := Cron().OnMinutes(30).EveryHour()
+	from = time.Date(2019, time.March, 9, 12, 0, 0, 0, loc)
+	if count := crn.Count(from, from.AddDate(0, 0, 2)); count != 47 {
+		t.Errorf("Expected the hour skipped by the transition not to be counted, got %d.", count)
+	}
+	from = time.Date(2019, time.November, 2, 12, 0, 0, 0, loc)
+	if count, expected := crn.Count(from, from.AddDate(0, 0, 2)), len(crn.Between(from, from.AddDate(0, 0, 2), 100)); count != expected {
+		t.Errorf("Expected %d dates around the repeated hour, got %d.", expected, count)
+	}
+
+	// every day produces 288 dates, but 12 of them are skipped by the spring transition.
+	crn = Cron().OnMinutes(BetweenMinutes(0, 55).Every(5)).EveryHour()
+	from = time.Date(2019, time.January, 1, 0, 0, 0, 0, loc)
+	to := from.AddDate(1, 0, 0)
+	if count, dates := crn.Count(from, to), crn.Between(from, to, math.MaxInt32); count != 365*288-12 || len(dates) != count {
+		t.Errorf("Expected %d dates, counted %d and listed %d.", 365*288-12, count, len(dates))
+	}
+}
+
+func TestSchedule_All(t *testing.T) {
+	dt1, dt2, dt3 := date().setDay(2).Time, date().setDay(3).Time, date().setDay(4).Time
+	var dates []time.Time
+	for at := range At(dt1, dt2, dt3).All() {
+		dates = append(dates, at)
+	}
+	if len(dates) != 3 || !dates[0].Equal(dt1) || !dates[2].Equal(dt3) {
+		t.Errorf("Unexpected dates returned %v.", dates)
+	}
+
+	sch := At(dt1, dt2, dt3)
+	for at := range sch.All() {
+		if at.Equal(dt2) {
+			break
+		}
+	}
+	if !sch.Following().Equal(dt2) || !sch.advanceX(t, 1).Equal(dt3) {
+		t.Error("Expected the Schedule to stop advancing when the iteration is stopped.")
+	}
+
+	sch = As(Cron().EveryMinute())
+	first := sch.Following()
+	for at := range sch.All() {
+		if !at.Equal(first) {
+			t.Errorf("Expected the iteration to start with %v, got %v.", first, at)
+		}
+		break
+	}
+	for at := range sch.All() {
+		if !at.Equal(first.Add(time.Minute)) {
+			t.Errorf("Expected the iteration to resume after %v, got %v.", first, at)
+		}
+		break
+	}
+	sch = As(Cron().EveryMinute())
+	first = sch.advanceX(t, 1)
+	for at := range sch.All() {
+		if !at.Equal(first.Add(time.Minute)) {
+			t.Errorf("Expected the iteration to start after %v, got %v.", first, at)
+		}
+		break
+	}
+
+	crnI := Cron().EveryDay().NewInstance(time.Date(200000000, time.December, 30, 0, 0, 0, 0, time.UTC))
+	count := 0
+	for at, err := range crnI.Dates() {
+		if err != nil {
+			if err != YearOutOfRangeError || !at.IsZero() || count != 1 {
+				t.Errorf("Unexpected error %v after %d dates.", err, count)
+			}
+			break
+		}
+		count++
+	}
+	if count != 1 {
+		t.Error("Expected the error to end the iteration.")
+	}
+}
+
+func TestCronExpression_From(t *testing.T) {
+	from := time.Date(2024, time.February, 26, 0, 0, 0, 0, time.UTC)
+	seq := Cron().OnHours(ListHours(9, 17)).EveryDay().From(from)
+	for range 2 {
+		var dates []time.Time
+		for at := range seq {
+			if dates = append(dates, at); len(dates) == 3 {
+				break
+			}
+		}
+		if !dates[0].Equal(from.Add(9*time.Hour)) || !dates[2].Equal(from.Add(33*time.Hour)) {
+			t.Errorf("Unexpected dates returned %v.", dates)
+		}
+	}
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+	for at := range Cron().OnMinutes(30).OnHours(2).From(time.Date(2019, time.March, 9, 12, 0, 0, 0, loc)) {
+		if !at.Equal(time.Date(2019, time.March, 11, 2, 30, 0, 0, loc)) {
+			t.Errorf("Expected the date skipped by the transition to be passed over, got %v.", at)
+		}
+		break
+	}
+}
+
+func TestCronInstance_SeekTo(t *testing.T) {
+	from := time.Date(2024, time.February, 26, 0, 0, 0, 0, time.UTC)
+	crnI := Cron().OnHours(ListHours(9, 17)).EveryDay().NewInstance(from)
+	crnI.advanceX(t, 5)
+	crnI.SeekTo(from.AddDate(0, 1, 0))
+	if !crnI.Following().Equal(from.AddDate(0, 1, 0)) || !crnI.advanceX(t, 1).Equal(from.AddDate(0, 1, 0).Add(9*time.Hour)) {
+		t.Error("Unexpected date returned after seeking forward.")
+	}
+	crnI.SeekTo(from)
+	if !crnI.advanceX(t, 2).Equal(from.Add(17 * time.Hour)) {
+		t.Error("Unexpected date returned after seeking backward.")
+	}
+
+	crnI = Cron().EveryDay().NewInstance(time.Date(200000000, time.December, 31, 0, 0, 0, 0, time.UTC))
+	if err := crnI.Next(); err != YearOutOfRangeError {
+		t.Error("Expected YearOutOfRangeError.")
+	}
+	crnI.SeekTo(from)
+	if !crnI.advanceX(t, 1).Equal(from.AddDate(0, 0, 1)) {
+		t.Error("Expected seeking to clear the previous error.")
+	}
+}
+
+func TestCronInstance_Peek(t *testing.T) {
+	from := time.Date(2024, time.February, 26, 0, 0, 0, 0, time.UTC)
+	crnI := Cron().OnHours(ListHours(9, 17)).EveryDay().NewInstance(from)
+	crnI.advanceX(t, 1)
+	dates := crnI.Peek(3)
+	if len(dates) != 3 || !dates[0].Equal(from.Add(17*time.Hour)) || !dates[2].Equal(from.Add(41*time.Hour)) {
+		t.Errorf("Unexpected dates returned %v.", dates)
+	}
+	if !crnI.Following().Equal(from.Add(9*time.Hour)) || !crnI.advanceX(t, 1).Equal(dates[0]) {
+		t.Error("Expected Peek not to advance the instance.")
+	}
+	if len(crnI.Peek(0)) != 0 {
+		t.Error("Expected no dates.")
+	}
+
+	sch := At(from, from.Add(time.Hour))
+	sch.AddCron(Cron().EveryDay())
+	dates = sch.Peek(3)
+	if len(dates) != 3 || !dates[0].Equal(from) || !dates[2].Equal(from.AddDate(0, 0, 1)) {
+		t.Errorf("Unexpected dates returned %v.", dates)
+	}
+	if !sch.Following().IsZero() || !sch.advanceX(t, 1).Equal(from) {
+		t.Error("Expected Peek not to advance the Schedule.")
+	}
+	sch.advanceX(t, 2)
+	if dates = sch.Peek(2); len(dates) != 2 || !dates[0].Equal(from.AddDate(0, 0, 2)) ||
+		!dates[1].Equal(from.AddDate(0, 0, 3)) || !sch.Following().Equal(from.AddDate(0, 0, 1)) {
+		t.Errorf("Unexpected dates returned %v.", dates)
+	}
+
+	sch = As(Cron().EveryMinute())
+	first := sch.Following()
+	if dates = sch.Peek(2); len(dates) != 2 || !dates[0].Equal(first.Add(time.Minute)) {
+		t.Errorf("Expected Peek to start after the following date, like CronInstance.Peek, got %v.", dates)
+	}
+	for at := range sch.All() {
+		if !at.Equal(first) {
+			t.Error("Expected Peek not to advance the Schedule.")
+		}
+		break
+	}
+}
+
 func date() *testDate {
 	return &testDate{time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)}
 }
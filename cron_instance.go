@@ -18,24 +18,81 @@ type CronInstance struct {
 	lastD bool
 	am    *AttunedMonth
 
+	// empty is set when a field of the expression holds no values, such as an empty IntersectExpression.
+	empty bool
+
 	err error
 }
 
 // Next uses it's following date to determine the next valid cron date according to it's expression.
 // Each subsequent execution advances the instance's following date.
+// It returns an ErrorCron describing why, when no following date can be determined.
 func (crnI *CronInstance) Next() error {
+	if crnI.empty {
+		return UnsatisfiableError
+	}
 	crnI.nextMs()
 	if crnI.err != nil {
 		return crnI.err
 	}
 	d := time.Date(crnI.am.Year(), crnI.am.Month(), crnI.d, crnI.h, crnI.min, crnI.s, crnI.ms*int(time.Millisecond), crnI.location)
+	// time.Date moves dates skipped by a transition, possibly before the following date, so they are identified first.
+	if d.Day() != crnI.d || d.Hour() != crnI.h || d.Minute() != crnI.min {
+		return DSTGapError
+	}
 	if !crnI.following.IsZero() && d.Before(crnI.following) {
-		return CronOutdatedInvalidError
+		return exhausted(crnI.following.Year())
 	}
 	crnI.following = d
 	return nil
 }
 
+// Previous uses it's following date to determine the previous valid cron date according to it's expression.
+// Each subsequent execution moves the instance's following date backward.
+// It returns an ErrorCron describing why, when no previous date can be determined, leaving the instance unchanged.
+func (crnI *CronInstance) Previous() error {
+	if crnI.empty {
+		return UnsatisfiableError
+	}
+	saved, am := *crnI, *crnI.am
+	crnI.prevMs()
+	if crnI.err != nil {
+		err := crnI.err
+		*crnI, *crnI.am = saved, am
+		return err
+	}
+	d := time.Date(crnI.am.Year(), crnI.am.Month(), crnI.d, crnI.h, crnI.min, crnI.s, crnI.ms*int(time.Millisecond), crnI.location)
+	if d.Day() != crnI.d || d.Hour() != crnI.h || d.Minute() != crnI.min {
+		return DSTGapError
+	}
+	if !crnI.following.IsZero() && d.After(crnI.following) {
+		*crnI, *crnI.am = saved, am
+		return ExhaustedError
+	}
+	crnI.following = d
+	return nil
+}
+
+// SeekTo repositions the instance at the provided date, as if it was created from it.
+// The following dates are determined from it, in its location. Any previous error is cleared.
+func (crnI *CronInstance) SeekTo(t time.Time) {
+	crnI.following = t
+	crnI.location = t.Location()
+	crnI.ms = t.Nanosecond() / int(time.Millisecond)
+	crnI.s = t.Second()
+	crnI.min = t.Minute()
+	crnI.h = t.Hour()
+	crnI.d = t.Day()
+	crnI.am.UpdateMonthYear(int(t.Month()), t.Year())
+	crnI.err = nil
+}
+
+// Peek returns up to n of the valid cron dates after the following date without modifying the instance's state.
+// Dates skipped by daylight saving time transitions are passed over.
+func (crnI *CronInstance) Peek(n int) []time.Time {
+	return peek(crnI.clone().All(), n)
+}
+
 // Following returns the following valid cron date determined by the Next function without modifying its state.
 func (crnI *CronInstance) Following() time.Time {
 	return crnI.following
@@ -88,8 +145,14 @@ func (crnI *CronInstance) nextD(inc bool) bool {
 		crnI.d, last, invalid = crnI.nextDay(crnI.d, inc)
 		inc = false
 		if invalid && last {
-			if reset = crnI.nextMonY(false); !reset || crnI.am.y-fromY > 400 {
-				crnI.err = CronOutdatedInvalidError
+			y := crnI.am.y
+			reset = crnI.nextMonY(false)
+			switch {
+			case !reset || crnI.am.y < y:
+				crnI.err = exhausted(y)
+				return false
+			case crnI.am.y-fromY > 400:
+				crnI.err = UnsatisfiableError
 				return false
 			}
 			crnI.d, inc = 1, true
@@ -113,8 +176,170 @@ func (crnI *CronInstance) nextMonY(inc bool) bool {
 }
 
 func (crnI *CronInstance) nextDay(fromD int, inc bool) (d int, last bool, invalid bool) {
-	d, last = next(crnI.crn.days, fromD, inc)
+	if crnI.crn.matchesAnyDay() {
+		return crnI.nextAnyDay(fromD, inc)
+	}
+	d, last = nextDay(crnI.crn.days, crnI.am, fromD, inc)
 	last = last || crnI.am.IsMonthLastDay(d)
-	invalid = d < fromD || !inc && d == fromD || !crnI.am.Contains(d) || !crnI.crn.containsWeekday(crnI.am.WeekDay(d))
+	invalid = d < fromD || !inc && d == fromD || !crnI.am.Contains(d) || !crnI.crn.containsWeekday(crnI.am, d) ||
+		!crnI.crn.containsDate(crnI.am, d)
 	return d, last, invalid
 }
+
+// nextAnyDay determines the next day of the month satisfying either the days or the weekdays.
+func (crnI *CronInstance) nextAnyDay(fromD int, inc bool) (d int, last bool, invalid bool) {
+	d = fromD
+	if !inc {
+		d++
+	}
+	for ; d <= crnI.am.MonthLastDay(); d++ {
+		if (crnI.crn.containsDay(crnI.am, d) || crnI.crn.containsWeekday(crnI.am, d)) && crnI.crn.containsDate(crnI.am, d) {
+			return d, crnI.am.IsMonthLastDay(d), false
+		}
+	}
+	return fromD, true, true
+}
+
+func (crnI *CronInstance) prevMs() {
+	fromMs := crnI.ms
+	ms, found := previous(crnI.crn.milliseconds, millisecondUnit, fromMs, false)
+	crnI.ms = ms
+	if crnI.prevS(found) {
+		crnI.ms, _ = previous(crnI.crn.milliseconds, millisecondUnit, millisecondUnit.max, true)
+	}
+}
+
+func (crnI *CronInstance) prevS(inc bool) bool {
+	fromS := crnI.s
+	s, found := previous(crnI.crn.seconds, secondUnit, fromS, inc)
+	crnI.s = s
+	if crnI.prevMin(found) {
+		crnI.s, _ = previous(crnI.crn.seconds, secondUnit, secondUnit.max, true)
+		return true
+	}
+	return crnI.s != fromS
+}
+
+func (crnI *CronInstance) prevMin(inc bool) bool {
+	fromMin := crnI.min
+	min, found := previous(crnI.crn.minutes, minuteUnit, fromMin, inc)
+	crnI.min = min
+	if crnI.prevH(found) {
+		crnI.min, _ = previous(crnI.crn.minutes, minuteUnit, minuteUnit.max, true)
+		return true
+	}
+	return crnI.min != fromMin
+}
+
+func (crnI *CronInstance) prevH(inc bool) bool {
+	fromH := crnI.h
+	h, found := previous(crnI.crn.hours, hourUnit, fromH, inc)
+	crnI.h = h
+	if crnI.prevD(found) {
+		crnI.h, _ = previous(crnI.crn.hours, hourUnit, hourUnit.max, true)
+		return true
+	}
+	return crnI.h != fromH
+}
+
+func (crnI *CronInstance) prevD(inc bool) bool {
+	fromD, fromY := crnI.d, crnI.am.y
+	reset := crnI.prevMonY(true)
+	if crnI.err != nil {
+		crnI.verifySatisfiable()
+		return false
+	}
+	if reset {
+		crnI.d, inc = crnI.am.MonthLastDay(), true
+	}
+	for {
+		if d, found := crnI.prevDay(crnI.d, inc); found {
+			crnI.d = d
+			return reset || crnI.d != fromD
+		}
+		crnI.prevMonY(false)
+		switch {
+		case crnI.err != nil:
+			crnI.verifySatisfiable()
+			return false
+		case fromY-crnI.am.y > 400:
+			crnI.err = UnsatisfiableError
+			return false
+		}
+		reset = true
+		crnI.d, inc = crnI.am.MonthLastDay(), true
+	}
+}
+
+func (crnI *CronInstance) prevMonY(inc bool) bool {
+	fromMon, fromY := crnI.am.mon, crnI.am.y
+	prevMon, found := previous(crnI.crn.months, monthUnit, fromMon, inc)
+	prevY, found := previous(crnI.crn.years, yearUnit, fromY, found)
+	if !found {
+		crnI.err = ExhaustedError
+		return false
+	}
+	if prevY != fromY {
+		prevMon, _ := previous(crnI.crn.months, monthUnit, monthUnit.max, true)
+		crnI.am.UpdateMonthYear(prevMon, prevY)
+		return true
+	}
+	crnI.am.UpdateMonth(prevMon)
+	return prevMon != fromMon
+}
+
+// verifySatisfiable replaces the ExhaustedError with the UnsatisfiableError when the expression can never produce a
+// date, so both directions report the same error.
+func (crnI *CronInstance) verifySatisfiable() {
+	first := time.Date(yearUnit.min, time.January, 1, 0, 0, 0, 0, crnI.location).Add(-time.Millisecond)
+	if crnI.err == ExhaustedError && crnI.crn.NewInstance(first).Next() == UnsatisfiableError {
+		crnI.err = UnsatisfiableError
+	}
+}
+
+// prevDay determines the previous day of the month satisfying the days and weekdays, reporting if one was found.
+func (crnI *CronInstance) prevDay(fromD int, inc bool) (int, bool) {
+	d := fromD
+	if !inc {
+		d--
+	}
+	for ; d >= 1; d-- {
+		if crnI.am.Contains(d) && crnI.crn.matchesDay(crnI.am, d) {
+			return d, true
+		}
+	}
+	return fromD, false
+}
+
+// nextDate behaves as Next, passing over the dates skipped by daylight saving time transitions.
+func (crnI *CronInstance) nextDate() error {
+	for {
+		if err := crnI.Next(); err != DSTGapError {
+			return err
+		}
+	}
+}
+
+// previousDate behaves as Previous, passing over the dates skipped by daylight saving time transitions.
+func (crnI *CronInstance) previousDate() error {
+	for {
+		if err := crnI.Previous(); err != DSTGapError {
+			return err
+		}
+	}
+}
+
+// clone returns a copy of the instance that can be advanced independently.
+func (crnI *CronInstance) clone() *CronInstance {
+	clone, am := *crnI, *crnI.am
+	clone.am = &am
+	return &clone
+}
+
+// exhausted determines the error of an instance that has no dates left after the provided year.
+func exhausted(y int) error {
+	if y >= yearUnit.max {
+		return YearOutOfRangeError
+	}
+	return ExhaustedError
+}
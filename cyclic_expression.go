@@ -0,0 +1,94 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+// CyclicExpression is the struct used to create cron range expressions that wrap around the end of their unit.
+type CyclicExpression struct {
+	x    int
+	y    int
+	step int
+	unit unit
+	list *ListExpression
+}
+
+// CyclicHours is an expression that generates the hours from x to y (*inclusive*), wrapping around midnight when x > y.
+// Example: CyclicHours(22, 2) = 22, 23, 0, 1, 2
+func CyclicHours(x int, y int) *CyclicExpression {
+	validateHour(x)
+	validateHour(y)
+	return cyclic(x, y, hourUnit)
+}
+
+// CyclicWeekdays is an expression that generates the weekdays from x to y (*inclusive*), wrapping around the end of the week when x > y.
+// Example: CyclicWeekdays(time.Friday, time.Monday) = Friday, Saturday, Sunday, Monday
+func CyclicWeekdays(x time.Weekday, y time.Weekday) *CyclicExpression {
+	validateWeekday(int(x))
+	validateWeekday(int(y))
+	return cyclic(int(x), int(y), weekdayUnit)
+}
+
+// CyclicMonths is an expression that generates the months from x to y (*inclusive*), wrapping around the end of the year when x > y.
+// Example: CyclicMonths(time.November, time.February) = November, December, January, February
+func CyclicMonths(x time.Month, y time.Month) *CyclicExpression {
+	validateMonth(int(x))
+	validateMonth(int(y))
+	return cyclic(int(x), int(y), monthUnit)
+}
+
+func cyclic(x int, y int, u unit) *CyclicExpression {
+	exp := &CyclicExpression{
+		x:    x,
+		y:    y,
+		step: 1,
+		unit: u,
+	}
+	exp.list = &ListExpression{values: exp.values()}
+	return exp
+}
+
+// Every allows optional specification of the stepping used for the cyclic logic.
+// Unlike BetweenExpression, the last value is only included when it is reachable by the step.
+// Example: CyclicHours(22, 3).Every(2) = 22, 0, 2
+func (exp *CyclicExpression) Every(s int) *CyclicExpression {
+	if s < 1 {
+		panic(InvalidStepError.Error())
+	}
+	exp.step = s
+	exp.list = &ListExpression{values: exp.values()}
+	return exp
+}
+
+// Next allows retrieval of the next value from this expression.
+// Expressions are stateless, the determination of their next value is based on input.
+// Given a valid expression value, the parameter inc is used to specify if it should be included in the output.
+// Given the last value of the expression or above, the inc parameter is ignored.
+// The values are iterated in ascending order, regardless of where the cycle starts.
+// It returns the next value according to provided parameters and a boolean indicating if it is the last value.
+func (exp *CyclicExpression) Next(from int, inc bool) (int, bool) {
+	return exp.list.Next(from, inc)
+}
+
+// Contains verifies if the provided value belongs to this expression.
+func (exp *CyclicExpression) Contains(val int) bool {
+	return exp.list.Contains(val)
+}
+
+// values lists the values of this expression in ascending order.
+func (exp *CyclicExpression) values() []int {
+	values := appendCycle(nil, exp.x, exp.y, exp.step, exp.unit)
+	sort.Ints(values)
+	return values
+}
+
+// appendCycle appends the values from x to y of the unit using the provided step, wrapping around the end of the unit.
+func appendCycle(values []int, x int, y int, step int, u unit) []int {
+	span := u.max - u.min + 1
+	length := (y - x + span) % span
+	for i := 0; i <= length; i += step {
+		values = append(values, u.min+(x-u.min+i)%span)
+	}
+	return values
+}
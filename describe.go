@@ -0,0 +1,264 @@
+package schedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	setAll = iota
+	setValues
+	setRange
+	setStep
+)
+
+// Locale is used to describe CronExpressions in a specific language.
+// The bundled locales are English, German, French, Portuguese and Japanese.
+type Locale interface {
+	// Month returns the name of the provided month.
+	Month(mon time.Month) string
+	// Weekday returns the name of the provided weekday.
+	Weekday(wd time.Weekday) string
+	// Clock returns the representation of a specific time of day.
+	Clock(h int, min int, s int, ms int) string
+	// Every describes every step-th value of a field ("every 15th minute").
+	Every(f CronField, step int) string
+	// Range describes every step-th value of a field between two values ("every hour from 9 through 17").
+	Range(f CronField, step int, from string, to string) string
+	// Values describes a set of specific values of a field ("minute 0 and 30").
+	Values(f CronField, values []string) string
+	// LastDay describes the last day of the month, or the day the provided amount of days before it.
+	LastDay(before int) string
+	// NearestWeekday describes the weekday nearest to the provided day of the month, d being 0 for the last day.
+	NearestWeekday(d int) string
+	// Weeks describes a cadence of every provided amount of weeks, starting on the anchor date ("2006-01-02").
+	Weeks(weeks int, anchor string) string
+	// NthWeekday describes the nth occurrence of the named weekday in the month, n being 0 for the last one.
+	NthWeekday(weekday string, n int) string
+	// Sentence composes the complete description from its parts.
+	Sentence(d Description) string
+}
+
+// Description holds the localized parts of a CronExpression description.
+// Empty parts are unrestricted and should be omitted.
+type Description struct {
+	// Clock holds the specific time of day, when there is one.
+	Clock string
+	// Time holds the parts describing the time of day otherwise, ordered from the finest field to the coarsest one.
+	Time     []string
+	Days     string
+	Weekdays string
+	// AnyDay reports whether dates satisfy either the Days or the Weekdays, instead of both.
+	AnyDay   bool
+	YearDays string
+	ISOWeeks string
+	Weeks    string
+	Months   string
+	Years    string
+}
+
+// valueSet is used to classify the values of an expression for description purposes.
+type valueSet struct {
+	kind   int
+	values []int
+	x      int
+	y      int
+	step   int
+}
+
+// Describe returns an English description of this expression.
+// Example: Cron().OnHours(22).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)).Describe():
+//
+//	"At 22:00 on every day-of-week from Monday through Friday."
+func (crn *CronExpression) Describe() string {
+	return crn.DescribeIn(English)
+}
+
+// DescribeIn returns a description of this expression in the language of the provided Locale.
+// Example: Cron().OnHours(22).OnWeekdays(BetweenWeekdays(time.Monday, time.Friday)).DescribeIn(German):
+//
+//	"Um 22:00 jeden Wochentag von Montag bis Freitag."
+func (crn *CronExpression) DescribeIn(loc Locale) string {
+	d := Description{
+		Days:     describeDays(crn.days, dayUnit, loc),
+		Weekdays: describeDays(crn.weekdays, weekdayUnit, loc),
+		AnyDay:   crn.matchesAnyDay(),
+		YearDays: describeSet(classify(crn.yearDays, yearDayUnit), yearDayUnit, loc),
+		ISOWeeks: describeSet(classify(crn.isoWeeks, isoWeekUnit), isoWeekUnit, loc),
+		Months:   describeSet(classify(crn.months, monthUnit), monthUnit, loc),
+		Years:    describeSet(classify(crn.years, yearUnit), yearUnit, loc),
+	}
+	if crn.weeks > 0 {
+		d.Weeks = loc.Weeks(crn.weeks, crn.weeksAnchorDate().Format(time.DateOnly))
+	}
+	crn.describeTime(&d, loc)
+	return loc.Sentence(d)
+}
+
+func (crn *CronExpression) describeTime(d *Description, loc Locale) {
+	ms := classify(crn.milliseconds, millisecondUnit)
+	if crn.milliseconds == nil {
+		ms = valueSet{kind: setValues, values: []int{0}}
+	}
+	s, min, h := classify(crn.seconds, secondUnit), classify(crn.minutes, minuteUnit), classify(crn.hours, hourUnit)
+	if ms.single() && s.single() && min.single() && h.single() {
+		d.Clock = loc.Clock(h.values[0], min.values[0], s.values[0], ms.values[0])
+		return
+	}
+
+	var parts []string
+	if !ms.isValue(0) {
+		parts = append(parts, describeEvery(ms, millisecondUnit, loc))
+	}
+	if !s.isValue(0) && (s.kind != setAll || len(parts) == 0) {
+		parts = append(parts, describeEvery(s, secondUnit, loc))
+	}
+	if min.kind != setAll || len(parts) == 0 {
+		parts = append(parts, describeEvery(min, minuteUnit, loc))
+	}
+	if h.kind != setAll {
+		parts = append(parts, describeEvery(h, hourUnit, loc))
+	}
+	d.Time = parts
+}
+
+func classify(exp Expression, u unit) valueSet {
+	switch exp := exp.(type) {
+	case nil:
+		return valueSet{kind: setAll}
+	case int:
+		return valueSet{kind: setValues, values: []int{exp}}
+	case time.Month:
+		return valueSet{kind: setValues, values: []int{int(exp)}}
+	case time.Weekday:
+		return valueSet{kind: setValues, values: []int{int(exp)}}
+	case *BetweenExpression:
+		return classifyBetween(exp, u)
+	case *ListExpression:
+		return valueSet{kind: setValues, values: exp.values}
+	case *CyclicExpression:
+		return valueSet{kind: setRange, x: exp.x, y: exp.y, step: exp.step}
+	case IteratorExpression:
+		return valueSet{kind: setValues, values: field{unit: u}.enumerate(exp)}
+	}
+	return valueSet{kind: setAll}
+}
+
+func classifyBetween(exp *BetweenExpression, u unit) valueSet {
+	switch {
+	case exp.x == exp.y:
+		return valueSet{kind: setValues, values: []int{exp.x}}
+	case exp.step == 1 && exp.x == u.min && exp.y == u.max:
+		return valueSet{kind: setAll}
+	case exp.step == 1:
+		return valueSet{kind: setRange, x: exp.x, y: exp.y, step: 1}
+	case exp.x == u.min && exp.y+exp.step > u.max && (exp.y-exp.x)%exp.step == 0:
+		return valueSet{kind: setStep, x: exp.x, y: exp.y, step: exp.step}
+	}
+	return valueSet{kind: setRange, x: exp.x, y: exp.y, step: exp.step}
+}
+
+func (set valueSet) single() bool {
+	return set.kind == setValues && len(set.values) == 1
+}
+
+func (set valueSet) isValue(v int) bool {
+	return set.single() && set.values[0] == v
+}
+
+// describeSet describes the provided set, unrestricted sets are described as empty.
+func describeSet(set valueSet, u unit, loc Locale) string {
+	if set.kind == setAll {
+		return ""
+	}
+	return describeEvery(set, u, loc)
+}
+
+// describeDays describes the days or weekdays, including the ones that depend on the month.
+func describeDays(exp Expression, u unit, loc Locale) string {
+	switch exp := exp.(type) {
+	case *LastDayExpression:
+		return loc.LastDay(exp.before)
+	case *NearestWeekdayExpression:
+		return loc.NearestWeekday(exp.d)
+	case *NthWeekdayExpression:
+		return loc.NthWeekday(loc.Weekday(exp.wd), exp.n)
+	}
+	return describeSet(classify(exp, u), u, loc)
+}
+
+func describeEvery(set valueSet, u unit, loc Locale) string {
+	switch set.kind {
+	case setAll:
+		return loc.Every(u.id, 1)
+	case setStep:
+		return loc.Every(u.id, set.step)
+	case setRange:
+		return loc.Range(u.id, set.step, valueName(set.x, u, loc), valueName(set.y, u, loc))
+	}
+
+	names := make([]string, len(set.values))
+	for i, v := range set.values {
+		names[i] = valueName(v, u, loc)
+	}
+	return loc.Values(u.id, names)
+}
+
+func valueName(v int, u unit, loc Locale) string {
+	switch u {
+	case weekdayUnit:
+		return loc.Weekday(time.Weekday(v))
+	case monthUnit:
+		return loc.Month(time.Month(v))
+	}
+	return strconv.Itoa(v)
+}
+
+// enumeration joins the provided names, using the conjunction before the last one.
+func enumeration(names []string, separator string, conjunction string) string {
+	switch len(names) {
+	case 0:
+		return ""
+	case 1:
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], separator) + conjunction + names[len(names)-1]
+}
+
+// joinParts joins the non empty parts using the provided separator.
+func joinParts(separator string, parts ...string) string {
+	var nonEmpty []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, separator)
+}
+
+func capitalize(text string) string {
+	r, size := utf8.DecodeRuneInString(text)
+	return string(unicode.ToUpper(r)) + text[size:]
+}
+
+func clock(h int, min int, s int, ms int) string {
+	text := pad(h, 2) + ":" + pad(min, 2)
+	if s != 0 || ms != 0 {
+		text += ":" + pad(s, 2)
+	}
+	if ms != 0 {
+		text += "." + pad(ms, 3)
+	}
+	return text
+}
+
+func pad(v int, width int) string {
+	text := strconv.Itoa(v)
+	for len(text) < width {
+		text = "0" + text
+	}
+	return text
+}
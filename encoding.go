@@ -0,0 +1,341 @@
+package schedule
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronJSON is the JSON representation of a CronExpression, holding the textual representation of each field.
+// Unset fields are omitted.
+type cronJSON struct {
+	Milliseconds string      `json:"milliseconds,omitempty"`
+	Seconds      string      `json:"seconds,omitempty"`
+	Minutes      string      `json:"minutes,omitempty"`
+	Hours        string      `json:"hours,omitempty"`
+	Days         string      `json:"days,omitempty"`
+	Weekdays     string      `json:"weekdays,omitempty"`
+	Months       string      `json:"months,omitempty"`
+	Years        string      `json:"years,omitempty"`
+	YearDays     string      `json:"yearDays,omitempty"`
+	ISOWeeks     string      `json:"isoWeeks,omitempty"`
+	DayMatching  DayMatching `json:"dayMatching,omitempty"`
+	Weeks        *weeksJSON  `json:"weeks,omitempty"`
+}
+
+// weeksJSON is the JSON representation of the week cadence of a CronExpression.
+type weeksJSON struct {
+	Every  int    `json:"every"`
+	Anchor string `json:"anchor"`
+}
+
+// betweenJSON is the JSON representation of a BetweenExpression.
+type betweenJSON struct {
+	X    int `json:"x"`
+	Y    int `json:"y"`
+	Step int `json:"step"`
+}
+
+// scheduleJSON is the JSON representation of a Schedule.
+type scheduleJSON struct {
+	At             []time.Time     `json:"at,omitempty"`
+	Cron           *CronExpression `json:"cron,omitempty"`
+	Following      *time.Time      `json:"following,omitempty"`
+	Anchor         *time.Time      `json:"anchor,omitempty"`
+	Every          string          `json:"every,omitempty"`
+	Window         *CronExpression `json:"window,omitempty"`
+	Location       string          `json:"location,omitempty"`
+	FollowingIndex int             `json:"followingIndex"`
+}
+
+//------CronExpression------//
+
+// MarshalText encodes this expression using its textual representation (see Text).
+// It returns the UnrepresentableError when the expression has no exact textual representation.
+func (crn *CronExpression) MarshalText() ([]byte, error) {
+	text, err := crn.Text()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
+
+// UnmarshalText decodes a standard crontab expression, a macro or a Quartz expression into this expression.
+func (crn *CronExpression) UnmarshalText(text []byte) error {
+	parsed, err := parseText(string(text))
+	if err != nil {
+		return err
+	}
+	*crn = *parsed
+	return nil
+}
+
+// MarshalJSON encodes this expression as an object holding the textual representation of each field.
+// Unlike the textual representation, it also preserves the milliseconds, days of the year, ISO weeks and week cadence.
+func (crn *CronExpression) MarshalJSON() ([]byte, error) {
+	aux := cronJSON{
+		Milliseconds: marshalField(crn.milliseconds, millisecondUnit),
+		Seconds:      marshalField(crn.seconds, secondUnit),
+		Minutes:      marshalField(crn.minutes, minuteUnit),
+		Hours:        marshalField(crn.hours, hourUnit),
+		Days:         marshalField(crn.days, dayUnit),
+		Weekdays:     marshalField(crn.weekdays, weekdayUnit),
+		Months:       marshalField(crn.months, monthUnit),
+		Years:        marshalField(crn.years, yearUnit),
+		YearDays:     marshalField(crn.yearDays, yearDayUnit),
+		ISOWeeks:     marshalField(crn.isoWeeks, isoWeekUnit),
+		DayMatching:  crn.dayMatching,
+	}
+	if crn.weeks > 0 {
+		aux.Weeks = &weeksJSON{Every: crn.weeks, Anchor: crn.weeksAnchorDate().Format(time.DateOnly)}
+	}
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON decodes an object produced by MarshalJSON into this expression.
+// It returns the NoFieldsError when the object holds no milliseconds, like CronBuilder.Build.
+func (crn *CronExpression) UnmarshalJSON(data []byte) error {
+	var aux cronJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	// the fields are set from the coarsest to the finest, so the defaults applied by each setter are overwritten.
+	decoded := Cron()
+	fields := [...]struct {
+		set  func(Expression) *CronExpression
+		text string
+		u    unit
+	}{
+		{decoded.OnYears, aux.Years, yearUnit},
+		{decoded.OnMonths, aux.Months, monthUnit},
+		{decoded.OnDays, aux.Days, dayUnit},
+		{decoded.OnWeekdays, aux.Weekdays, weekdayUnit},
+		{decoded.OnYearDays, aux.YearDays, yearDayUnit},
+		{decoded.OnISOWeeks, aux.ISOWeeks, isoWeekUnit},
+		{decoded.OnHours, aux.Hours, hourUnit},
+		{decoded.OnMinutes, aux.Minutes, minuteUnit},
+		{decoded.OnSeconds, aux.Seconds, secondUnit},
+		{decoded.OnMilliseconds, aux.Milliseconds, millisecondUnit},
+	}
+	for _, f := range fields {
+		if f.text == "" {
+			continue
+		}
+		exp, err := field{unit: f.u}.parse(token{text: f.text})
+		if err != nil {
+			return err
+		}
+		f.set(exp)
+	}
+	decoded.dayMatching = aux.DayMatching
+	if aux.Weeks != nil {
+		anchor, err := time.Parse(time.DateOnly, aux.Weeks.Anchor)
+		if err != nil {
+			return err
+		}
+		if aux.Weeks.Every < 1 {
+			return InvalidStepError
+		}
+		decoded.EveryWeeks(aux.Weeks.Every, anchor)
+	}
+	if decoded.milliseconds == nil {
+		return NoFieldsError
+	}
+	*crn = *decoded
+	return nil
+}
+
+func marshalField(exp Expression, u unit) string {
+	if exp == nil {
+		return ""
+	}
+	return formatField(exp, field{unit: u})
+}
+
+//------BetweenExpression------//
+
+// MarshalText encodes this expression as "x-y/step".
+func (exp *BetweenExpression) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(exp.x) + "-" + strconv.Itoa(exp.y) + "/" + strconv.Itoa(exp.step)), nil
+}
+
+// UnmarshalText decodes "x-y" or "x-y/step" into this expression.
+func (exp *BetweenExpression) UnmarshalText(text []byte) error {
+	body, stepText, hasStep := strings.Cut(string(text), "/")
+	xText, yText, isRange := strings.Cut(body, "-")
+	x, bad, ok := parseNumber(xText)
+	if !ok {
+		return &ErrorParse{Position: bad, Reason: "invalid value " + strconv.Quote(xText)}
+	}
+	if !isRange {
+		return &ErrorParse{Position: len(body), Reason: "missing range end"}
+	}
+	y, bad, ok := parseNumber(yText)
+	if !ok {
+		return &ErrorParse{Position: len(xText) + 1 + bad, Reason: "invalid value " + strconv.Quote(yText)}
+	}
+	step := 1
+	if hasStep {
+		if step, bad, ok = parseNumber(stepText); !ok {
+			return &ErrorParse{Position: len(body) + 1 + bad, Reason: "invalid step " + strconv.Quote(stepText)}
+		}
+	}
+	return exp.set(x, y, step)
+}
+
+// MarshalJSON encodes this expression as an object holding its x, y and step values.
+func (exp *BetweenExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(betweenJSON{X: exp.x, Y: exp.y, Step: exp.step})
+}
+
+// UnmarshalJSON decodes an object produced by MarshalJSON into this expression.
+func (exp *BetweenExpression) UnmarshalJSON(data []byte) error {
+	aux := betweenJSON{Step: 1}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return exp.set(aux.X, aux.Y, aux.Step)
+}
+
+//------ListExpression------//
+
+// MarshalText encodes this expression as comma separated values.
+func (exp *ListExpression) MarshalText() ([]byte, error) {
+	text := make([]string, len(exp.values))
+	for i, v := range exp.values {
+		text[i] = strconv.Itoa(v)
+	}
+	return []byte(strings.Join(text, ",")), nil
+}
+
+// UnmarshalText decodes comma separated values into this expression.
+func (exp *ListExpression) UnmarshalText(text []byte) error {
+	var values []int
+	pos := 0
+	for _, item := range strings.Split(string(text), ",") {
+		v, bad, ok := parseNumber(item)
+		if !ok {
+			return &ErrorParse{Position: pos + bad, Reason: "invalid value " + strconv.Quote(item)}
+		}
+		values = append(values, v)
+		pos += len(item) + 1
+	}
+	return exp.set(values)
+}
+
+// MarshalJSON encodes this expression as an array of its values.
+func (exp *ListExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(exp.values)
+}
+
+// UnmarshalJSON decodes an array of values into this expression.
+func (exp *ListExpression) UnmarshalJSON(data []byte) error {
+	var values []int
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	return exp.set(values)
+}
+
+//------Schedule------//
+
+// MarshalJSON encodes this schedule, including its current state.
+// Dates are encoded with their offset, along with the name of the time.Location the following dates are determined in,
+// when it can be loaded using time.LoadLocation.
+func (sch *Schedule) MarshalJSON() ([]byte, error) {
+	aux := scheduleJSON{
+		At:             sch.at,
+		Cron:           sch.crn,
+		FollowingIndex: sch.followingIndex,
+	}
+	if loc := sch.location(); loc != nil && loc != time.UTC && loc.String() != "" {
+		if _, err := time.LoadLocation(loc.String()); err == nil {
+			aux.Location = loc.String()
+		}
+	}
+	if sch.crnI != nil {
+		following := sch.crnI.Following()
+		aux.Following = &following
+	}
+	if sch.every > 0 {
+		aux.Anchor = &sch.anchor
+		aux.Every = sch.every.String()
+		aux.Window = sch.window
+	}
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON decodes an object produced by MarshalJSON into this schedule, restoring its state.
+// The dates are restored in the time.Location encoded, so CronExpressions keep following its transitions.
+func (sch *Schedule) UnmarshalJSON(data []byte) error {
+	var aux scheduleJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var every time.Duration
+	if aux.Every != "" {
+		var err error
+		if every, err = time.ParseDuration(aux.Every); err != nil {
+			return err
+		}
+		if every <= 0 {
+			return NoIntervalError
+		}
+	}
+	// past the scheduled times, the following date is determined by the CronExpression, so both must be provided.
+	if aux.FollowingIndex < -1 || aux.Following != nil && aux.Cron == nil || every == 0 &&
+		aux.FollowingIndex >= len(aux.At) && (aux.FollowingIndex > len(aux.At) || aux.Following == nil || aux.Cron == nil) {
+		return InvalidScheduleError
+	}
+	if err := validateOrder(aux.At); err != nil {
+		return err
+	}
+	if aux.Location != "" {
+		loc, err := time.LoadLocation(aux.Location)
+		if err != nil {
+			return err
+		}
+		for i := range aux.At {
+			aux.At[i] = aux.At[i].In(loc)
+		}
+		for _, t := range []*time.Time{aux.Following, aux.Anchor} {
+			if t != nil {
+				*t = t.In(loc)
+			}
+		}
+	}
+
+	decoded := Schedule{
+		at:             aux.At,
+		crn:            aux.Cron,
+		followingIndex: aux.FollowingIndex,
+	}
+	if aux.Following != nil && aux.Cron != nil {
+		decoded.crnI = aux.Cron.NewInstance(*aux.Following)
+	}
+	if every > 0 {
+		if aux.Anchor != nil {
+			decoded.anchor = *aux.Anchor
+		}
+		decoded.every = every
+		decoded.window = aux.Window
+	}
+	*sch = decoded
+	return nil
+}
+
+// location returns the time.Location of the date the following dates of this schedule are determined from.
+func (sch *Schedule) location() *time.Location {
+	switch {
+	case sch.crnI != nil:
+		return sch.crnI.Following().Location()
+	case sch.every > 0:
+		return sch.anchor.Location()
+	case len(sch.at) > 0:
+		return sch.at[len(sch.at)-1].Location()
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package schedule
 
+import "strconv"
+
 // ErrorOutdatedInvalidCron is used to represent a cron that has entered an invalid state or is outdated.
+// The precise reason is represented by the ErrorCron errors, all of which but DSTGapError match it using errors.Is.
 type ErrorOutdatedInvalidCron string
 
 // CronOutdatedInvalidError is a constant equivalent of the ErrorOutdatedInvalidCron error.
@@ -12,6 +15,7 @@ func (e ErrorOutdatedInvalidCron) Error() string {
 }
 
 // ErrorOutdated is used to represent a schedule that became outdated.
+// The precise reason is represented by the ErrorCron errors, all of which but DSTGapError match it using errors.Is.
 type ErrorOutdated string
 
 // OutdatedError is a constant equivalent of the ErrorOutdated error.
@@ -21,3 +25,133 @@ const OutdatedError = ErrorOutdated("schedule: outdated or invalid Schedule")
 func (e ErrorOutdated) Error() string {
 	return string(e)
 }
+
+// ErrorCron is used to represent the reason a CronInstance or a Schedule cannot produce its following date.
+type ErrorCron string
+
+const (
+	// ExhaustedError is a constant equivalent of the ErrorCron error for expressions and schedules that have no dates left.
+	ExhaustedError = ErrorCron("schedule: no following dates left")
+	// UnsatisfiableError is a constant equivalent of the ErrorCron error for expressions that can never produce a date.
+	// Example: Cron().OnMonths(time.February).OnDays(30).
+	UnsatisfiableError = ErrorCron("schedule: CronExpression can never be satisfied")
+	// YearOutOfRangeError is a constant equivalent of the ErrorCron error for dates beyond the last supported year.
+	YearOutOfRangeError = ErrorCron("schedule: year out of range")
+	// DSTGapError is a constant equivalent of the ErrorCron error for dates skipped by a daylight saving time transition.
+	// The instance's state is still advanced, so the following execution of Next skips the nonexistent date.
+	// It is only returned by CronInstances, Schedules skip these dates. Unlike the other ErrorCron errors, it does not
+	// match CronOutdatedInvalidError nor OutdatedError, since the instance is not outdated.
+	DSTGapError = ErrorCron("schedule: date skipped by a daylight saving time transition")
+)
+
+// Error produces a string message of this error.
+func (e ErrorCron) Error() string {
+	return string(e)
+}
+
+// Is reports whether this error matches the target.
+// Every ErrorCron but DSTGapError matches CronOutdatedInvalidError and OutdatedError, which represent them in previous
+// versions.
+func (e ErrorCron) Is(target error) bool {
+	return e != DSTGapError && (target == CronOutdatedInvalidError || target == OutdatedError)
+}
+
+// ErrorParse is used to represent a textual cron expression that could not be parsed.
+type ErrorParse struct {
+	// Field is the name of the cron field containing the offending character.
+	// It is empty when the expression as a whole is malformed.
+	Field string
+	// Position is the zero-based offset of the offending character in the parsed string.
+	Position int
+	// Reason describes why the field is invalid.
+	Reason string
+}
+
+// Error produces a string message of this error.
+func (e *ErrorParse) Error() string {
+	if e.Field == "" {
+		return "schedule: invalid cron expression at position " + strconv.Itoa(e.Position) + ": " + e.Reason
+	}
+	return "schedule: invalid " + e.Field + " field at position " + strconv.Itoa(e.Position) + ": " + e.Reason
+}
+
+// ErrorInvalidExpression is used to represent an expression that cannot be created from the provided values.
+type ErrorInvalidExpression string
+
+const (
+	// InvalidBetweenError is a constant equivalent of the ErrorInvalidExpression error for BetweenExpressions.
+	InvalidBetweenError = ErrorInvalidExpression("schedule: invalid BetweenExpression expression")
+	// InvalidListError is a constant equivalent of the ErrorInvalidExpression error for ListExpressions.
+	InvalidListError = ErrorInvalidExpression("schedule: invalid ListExpression expression")
+	// InvalidStepError is a constant equivalent of the ErrorInvalidExpression error for step values.
+	InvalidStepError = ErrorInvalidExpression("schedule: invalid step value")
+	// InvalidOffsetError is a constant equivalent of the ErrorInvalidExpression error for day offsets.
+	InvalidOffsetError = ErrorInvalidExpression("schedule: invalid offset value")
+	// InvalidOccurrenceError is a constant equivalent of the ErrorInvalidExpression error for weekday occurrences.
+	InvalidOccurrenceError = ErrorInvalidExpression("schedule: invalid occurrence value")
+	// InvalidCombinationError is a constant equivalent of the ErrorInvalidExpression error for combinations without expressions.
+	InvalidCombinationError = ErrorInvalidExpression("schedule: invalid combination of expressions")
+	// UnsupportedExpressionError is a constant equivalent of the ErrorInvalidExpression error for unsupported types.
+	UnsupportedExpressionError = ErrorInvalidExpression("schedule: unsupported expression type")
+	// NoFieldsError is a constant equivalent of the ErrorInvalidExpression error for CronExpressions without any field set.
+	NoFieldsError = ErrorInvalidExpression("schedule: no CronExpression fields were provided")
+)
+
+// Error produces a string message of this error.
+func (e ErrorInvalidExpression) Error() string {
+	return string(e)
+}
+
+// ErrorScan is used to represent a database value that cannot be scanned.
+type ErrorScan string
+
+// ScanError is a constant equivalent of the ErrorScan error.
+const ScanError = ErrorScan("schedule: CronExpression can only be scanned from text")
+
+// Error produces a string message of this error.
+func (e ErrorScan) Error() string {
+	return string(e)
+}
+
+// ErrorFormat is used to represent a CronExpression that has no exact textual representation.
+type ErrorFormat string
+
+// UnrepresentableError is a constant equivalent of the ErrorFormat error.
+const UnrepresentableError = ErrorFormat("schedule: CronExpression has no exact textual representation")
+
+// Error produces a string message of this error.
+func (e ErrorFormat) Error() string {
+	return string(e)
+}
+
+// ErrorInvalidValue is used to represent a value outside of the valid range of its field.
+type ErrorInvalidValue struct {
+	Field CronField
+	Value int
+}
+
+// Error produces a string message of this error.
+func (e *ErrorInvalidValue) Error() string {
+	return "schedule: invalid " + e.Field.String() + " value"
+}
+
+// ErrorInvalidSchedule is used to represent a schedule that cannot be created from the provided values.
+type ErrorInvalidSchedule string
+
+const (
+	// NoTimesError is a constant equivalent of the ErrorInvalidSchedule error for missing times.
+	NoTimesError = ErrorInvalidSchedule("schedule: at least one time must be provided")
+	// TimeOrderError is a constant equivalent of the ErrorInvalidSchedule error for unordered times.
+	TimeOrderError = ErrorInvalidSchedule("schedule: time order provided is invalid")
+	// NoDurationsError is a constant equivalent of the ErrorInvalidSchedule error for missing durations.
+	NoDurationsError = ErrorInvalidSchedule("schedule: at least one duration must be provided")
+	// NoIntervalError is a constant equivalent of the ErrorInvalidSchedule error for non positive intervals.
+	NoIntervalError = ErrorInvalidSchedule("schedule: interval must be positive")
+	// InvalidScheduleError is a constant equivalent of the ErrorInvalidSchedule error for inconsistent states.
+	InvalidScheduleError = ErrorInvalidSchedule("schedule: invalid Schedule state")
+)
+
+// Error produces a string message of this error.
+func (e ErrorInvalidSchedule) Error() string {
+	return string(e)
+}
@@ -9,3 +9,10 @@ type IteratorExpression interface {
 	Next(from int, inc bool) (int, bool)
 	Contains(val int) bool
 }
+
+// DayExpression is used by expressions whose days depend on the month they are evaluated in.
+// They may be used for both the days and the weekdays of a CronExpression.
+type DayExpression interface {
+	NextDay(am *AttunedMonth, from int, inc bool) (int, bool)
+	ContainsDay(am *AttunedMonth, d int) bool
+}
@@ -0,0 +1,209 @@
+package schedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronFormat identifies the textual layout produced by CronExpression.Format.
+type CronFormat int
+
+const (
+	// StandardFormat is the 5-field crontab layout accepted by Parse.
+	StandardFormat CronFormat = iota
+	// QuartzFormat is the 7-field Quartz layout accepted by ParseQuartz.
+	QuartzFormat
+)
+
+// String returns the textual representation of this expression.
+// The standard crontab layout is used, unless seconds or years are restricted, in which case the Quartz layout is used.
+// The Quartz layout is also used when both days and weekdays are restricted and combined with DaysAndWeekdays,
+// unless either of them starts with "*".
+// Milliseconds, days of the year, ISO weeks and the week cadence have no textual representation and are therefore omitted.
+// The result can be parsed back using Parse or ParseQuartz respectively. Use Text to detect the omissions.
+func (crn *CronExpression) String() string {
+	return crn.Format(crn.layout())
+}
+
+// Text returns the textual representation of this expression, as String does.
+// Unlike String, it returns the UnrepresentableError when the text would not parse back into an equivalent expression.
+// That is the case of milliseconds, days of the year, ISO weeks, the week cadence, DaysOrWeekdays combined with
+// seconds or years, and DayExpressions without a textual representation.
+func (crn *CronExpression) Text() (string, error) {
+	if crn.milliseconds != nil && !isZero(crn.milliseconds) || crn.yearDays != nil || crn.isoWeeks != nil ||
+		crn.weeks > 0 || crn.layout() == QuartzFormat && crn.matchesAnyDay() {
+		return "", UnrepresentableError
+	}
+	fields := [...]Expression{crn.seconds, crn.minutes, crn.hours, crn.days, crn.months, crn.weekdays, crn.years}
+	for i, exp := range fields {
+		if formatField(exp, quartzFields[i]) == "" {
+			return "", UnrepresentableError
+		}
+	}
+	return crn.String(), nil
+}
+
+// layout determines the layout used by String.
+func (crn *CronExpression) layout() CronFormat {
+	// the standard layout combines restricted days and weekdays with DaysAndWeekdays only when either starts with "*".
+	starred := strings.HasPrefix(formatField(crn.days, standardFields[2]), "*") ||
+		strings.HasPrefix(formatField(crn.weekdays, standardFields[4]), "*")
+	bothDays := !isAll(crn.days, dayUnit) && !isAll(crn.weekdays, weekdayUnit)
+	if isZero(crn.seconds) && isAll(crn.years, yearUnit) && (crn.dayMatching == DaysOrWeekdays || !bothDays || starred) {
+		return StandardFormat
+	}
+	return QuartzFormat
+}
+
+// Format returns the textual representation of this expression using the provided layout.
+// The standard layout omits seconds and years, these are only represented by the Quartz layout.
+// The Quartz layout is parsed with DaysAndWeekdays. The standard layout is parsed with DaysOrWeekdays, unless the
+// day-of-month or day-of-week starts with "*", so these are written as ranges when combined with DaysOrWeekdays.
+// Example: Cron().OnMinutes(Between(0, 45).Every(15)).OnHours(Between(9, 17)).Format(QuartzFormat):
+//
+//	"0 */15 9-17 * * ? *"
+func (crn *CronExpression) Format(f CronFormat) string {
+	if f == QuartzFormat {
+		days, weekdays := formatField(crn.days, quartzFields[3]), formatField(crn.weekdays, quartzFields[5])
+		switch {
+		case isAll(crn.weekdays, weekdayUnit):
+			weekdays = "?"
+		case isAll(crn.days, dayUnit):
+			days = "?"
+		}
+		return strings.Join([]string{
+			formatField(crn.seconds, quartzFields[0]),
+			formatField(crn.minutes, quartzFields[1]),
+			formatField(crn.hours, quartzFields[2]),
+			days,
+			formatField(crn.months, quartzFields[4]),
+			weekdays,
+			formatField(crn.years, quartzFields[6]),
+		}, " ")
+	}
+
+	days, weekdays := formatField(crn.days, standardFields[2]), formatField(crn.weekdays, standardFields[4])
+	if crn.matchesAnyDay() {
+		days, weekdays = standardFields[2].expandAll(days), standardFields[4].expandAll(weekdays)
+	}
+	return strings.Join([]string{
+		formatField(crn.minutes, standardFields[0]),
+		formatField(crn.hours, standardFields[1]),
+		days,
+		formatField(crn.months, standardFields[3]),
+		weekdays,
+	}, " ")
+}
+
+func formatField(exp Expression, f field) string {
+	switch exp := exp.(type) {
+	case nil:
+		return "*"
+	case int:
+		return f.formatValue(exp)
+	case time.Month:
+		return f.formatValue(int(exp))
+	case time.Weekday:
+		return f.formatValue(int(exp))
+	case *BetweenExpression:
+		return f.formatBetween(exp)
+	case *ListExpression:
+		return f.formatValues(exp.values)
+	case *CyclicExpression:
+		text := f.formatValue(exp.x) + "-" + f.formatValue(exp.y)
+		if exp.step > 1 {
+			text += "/" + strconv.Itoa(exp.step)
+		}
+		return text
+	case IteratorExpression:
+		return f.formatValues(f.enumerate(exp))
+	case *LastDayExpression:
+		if exp.before > 0 {
+			return "L-" + strconv.Itoa(exp.before)
+		}
+		return "L"
+	case *NearestWeekdayExpression:
+		if exp.d == 0 {
+			return "LW"
+		}
+		return f.formatValue(exp.d) + "W"
+	case *NthWeekdayExpression:
+		if exp.n == 0 {
+			return f.formatValue(int(exp.wd)) + "L"
+		}
+		return f.formatValue(int(exp.wd)) + "#" + strconv.Itoa(exp.n)
+	}
+	return ""
+}
+
+// expandAll replaces the leading "*" of a formatted field with the range of its unit.
+func (f field) expandAll(text string) string {
+	if rest, found := strings.CutPrefix(text, "*"); found {
+		return f.formatValue(f.min) + "-" + f.formatValue(f.max) + rest
+	}
+	return text
+}
+
+func (f field) formatValue(v int) string {
+	return strconv.Itoa(v + f.offset)
+}
+
+func (f field) formatValues(values []int) string {
+	text := make([]string, len(values))
+	for i, v := range values {
+		text[i] = f.formatValue(v)
+	}
+	return strings.Join(text, ",")
+}
+
+func (f field) formatBetween(exp *BetweenExpression) string {
+	switch {
+	case exp.x == exp.y:
+		return f.formatValue(exp.x)
+	case exp.step == 1 && exp.x == f.min && exp.y == f.max:
+		return "*"
+	case exp.step == 1:
+		return f.formatValue(exp.x) + "-" + f.formatValue(exp.y)
+	}
+
+	// the last value of a BetweenExpression is always included, even when it is not reachable by its step.
+	last := exp.y - (exp.y-exp.x)%exp.step
+	text := f.formatValue(exp.x) + "-" + f.formatValue(last) + "/" + strconv.Itoa(exp.step)
+	if exp.x == f.min && last+exp.step > f.max {
+		text = "*/" + strconv.Itoa(exp.step)
+	}
+	if last != exp.y {
+		text += "," + f.formatValue(exp.y)
+	}
+	return text
+}
+
+// enumerate lists the values of an expression that belong to the field's unit.
+func (f field) enumerate(exp IteratorExpression) []int {
+	var values []int
+	v, last := exp.Next(f.min, true)
+	for v <= f.max && (len(values) == 0 || v > values[len(values)-1]) {
+		if f.contains(v) && exp.Contains(v) {
+			values = append(values, v)
+		}
+		if last {
+			break
+		}
+		v, last = exp.Next(v, false)
+	}
+	return values
+}
+
+func isZero(exp Expression) bool {
+	v, iOf := exp.(int)
+	return iOf && v == 0
+}
+
+func isAll(exp Expression, u unit) bool {
+	if exp == nil {
+		return true
+	}
+	between, iOf := exp.(*BetweenExpression)
+	return iOf && between.step == 1 && between.x == u.min && between.y == u.max
+}
@@ -0,0 +1,101 @@
+package schedule
+
+import (
+	"iter"
+	"time"
+)
+
+// All returns an iterator over the following dates of the referenced Schedule, advancing it on each iteration.
+// The schedules created by As start with the date they are positioned on, unless it was yielded or passed by Next already.
+// The iteration ends when no following date can be produced. Use Dates to also receive the error ending the iteration.
+func (sch *Schedule) All() iter.Seq[time.Time] {
+	return sch.iterator().all
+}
+
+// Dates returns an iterator over the following dates of the referenced Schedule, advancing it on each iteration.
+// The schedules created by As start with the date they are positioned on, unless it was yielded or passed by Next already.
+// Unlike All, when the iteration ends for any reason other than an ExhaustedError, the error is yielded with a zero
+// time.Time.
+func (sch *Schedule) Dates() iter.Seq2[time.Time, error] {
+	return sch.iterator().dates
+}
+
+func (sch *Schedule) iterator() dateIterator {
+	return dateIterator{next: sch.Next, following: sch.Following, pending: &sch.pending}
+}
+
+// All returns an iterator over the following dates of the referenced CronInstance, advancing it on each iteration.
+// The iteration ends when no following date can be determined, dates skipped by daylight saving time transitions are
+// passed over. Use Dates to also receive the error ending the iteration.
+func (crnI *CronInstance) All() iter.Seq[time.Time] {
+	return dateIterator{next: crnI.Next, following: crnI.Following}.all
+}
+
+// Dates returns an iterator over the following dates of the referenced CronInstance, advancing it on each iteration.
+// Unlike All, when the iteration ends for any reason other than an ExhaustedError, the error is yielded with a zero
+// time.Time.
+func (crnI *CronInstance) Dates() iter.Seq2[time.Time, error] {
+	return dateIterator{next: crnI.Next, following: crnI.Following}.dates
+}
+
+// From returns an iterator over the dates produced by the referenced CronExpression after the provided date.
+// Each iteration uses a new CronInstance, so the iterator can be ranged over repeatedly.
+func (crn *CronExpression) From(from time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		crn.NewInstance(from).All()(yield)
+	}
+}
+
+// peek collects up to n dates of the iterator.
+func peek(seq iter.Seq[time.Time], n int) []time.Time {
+	dates := make([]time.Time, 0, max(n, 0))
+	if n < 1 {
+		return dates
+	}
+	for t := range seq {
+		if dates = append(dates, t); len(dates) == n {
+			break
+		}
+	}
+	return dates
+}
+
+// dateIterator adapts the Next and Following functions of a Schedule or CronInstance into iterators.
+// While pending is set, the following date is yielded once before advancing.
+type dateIterator struct {
+	next      func() error
+	following func() time.Time
+	pending   *bool
+}
+
+func (it dateIterator) all(yield func(time.Time) bool) {
+	for t, err := range it.dates {
+		if err != nil || !yield(t) {
+			return
+		}
+	}
+}
+
+func (it dateIterator) dates(yield func(time.Time, error) bool) {
+	if it.pending != nil && *it.pending {
+		*it.pending = false
+		if !yield(it.following(), nil) {
+			return
+		}
+	}
+	for {
+		err := it.next()
+		switch err {
+		case nil:
+			if !yield(it.following(), nil) {
+				return
+			}
+		case DSTGapError:
+		case ExhaustedError:
+			return
+		default:
+			yield(time.Time{}, err)
+			return
+		}
+	}
+}
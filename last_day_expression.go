@@ -0,0 +1,35 @@
+package schedule
+
+// LastDayExpression is the struct used to create last day of the month expressions.
+type LastDayExpression struct {
+	before int
+}
+
+// LastDay is an expression that generates the last day of every month.
+func LastDay() *LastDayExpression {
+	return &LastDayExpression{}
+}
+
+// Before allows optional specification of the amount of days before the last day of the month.
+// Example: LastDay().Before(3)
+//
+//   - January = 28
+//   - February (leap year) = 26
+func (exp *LastDayExpression) Before(days int) *LastDayExpression {
+	if days < 0 || days > 30 {
+		panic(InvalidOffsetError.Error())
+	}
+	exp.before = days
+	return exp
+}
+
+// NextDay allows retrieval of the next day from this expression, within the provided month.
+// It behaves as the Next function of IteratorExpressions, the only value of this expression being its last one.
+func (exp *LastDayExpression) NextDay(am *AttunedMonth, from int, inc bool) (int, bool) {
+	return am.MonthLastDay() - exp.before, true
+}
+
+// ContainsDay verifies if the provided day of the month belongs to this expression.
+func (exp *LastDayExpression) ContainsDay(am *AttunedMonth, d int) bool {
+	return d == am.MonthLastDay()-exp.before
+}
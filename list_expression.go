@@ -13,7 +13,7 @@ type ListExpression struct {
 // List is an expression used to iterate the provided list of int parameters (*inclusive*).
 func List(values []int) *ListExpression {
 	if len(values) < 1 {
-		panic("schedule: invalid ListExpression expression")
+		panic(InvalidListError.Error())
 	}
 	sort.Ints(values)
 	return &ListExpression{
@@ -21,6 +21,16 @@ func List(values []int) *ListExpression {
 	}
 }
 
+// NewList creates an expression used to iterate the provided list of int parameters (*inclusive*).
+// Unlike List, it returns an ErrorInvalidExpression instead of panicking when no values are provided.
+func NewList(values []int) (*ListExpression, error) {
+	exp := &ListExpression{}
+	if err := exp.set(values); err != nil {
+		return nil, err
+	}
+	return exp, nil
+}
+
 // ListMilliseconds uses the regular list logic, ensuring valid millisecond parameters.
 func ListMilliseconds(values ...int) *ListExpression {
 	for _, v := range values {
@@ -91,6 +101,22 @@ func ListYears(values ...int) *ListExpression {
 	return List(values)
 }
 
+// ListYearDays uses the regular list logic, ensuring valid day of the year parameters.
+func ListYearDays(values ...int) *ListExpression {
+	for _, v := range values {
+		validateYearDay(v)
+	}
+	return List(values)
+}
+
+// ListISOWeeks uses the regular list logic, ensuring valid ISO week parameters.
+func ListISOWeeks(values ...int) *ListExpression {
+	for _, v := range values {
+		validateISOWeek(v)
+	}
+	return List(values)
+}
+
 // Next allows retrieval of the next value from this expression.
 // Expressions are stateless, the determination of their next value is based on input.
 // Given a valid expression value, the parameter inc is used to specify if it should be included in the output.
@@ -123,3 +149,11 @@ func (exp *ListExpression) Contains(val int) bool {
 	}
 	return false
 }
+
+func (exp *ListExpression) set(values []int) error {
+	if len(values) < 1 {
+		return InvalidListError
+	}
+	exp.values = List(values).values
+	return nil
+}
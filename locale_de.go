@@ -0,0 +1,129 @@
+package schedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// German is the Locale used to describe CronExpressions in German.
+var German Locale = german{}
+
+type german struct{}
+
+var germanMonths = [...]string{
+	"Januar", "Februar", "März", "April", "Mai", "Juni",
+	"Juli", "August", "September", "Oktober", "November", "Dezember",
+}
+
+var germanWeekdays = [...]string{
+	"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag",
+}
+
+// germanFields holds the name of each field and the matching declension of "every".
+var germanFields = [...]struct {
+	name  string
+	every string
+}{
+	MillisecondField: {name: "Millisekunde", every: "jede"},
+	SecondField:      {name: "Sekunde", every: "jede"},
+	MinuteField:      {name: "Minute", every: "jede"},
+	HourField:        {name: "Stunde", every: "jede"},
+	DayField:         {name: "Tag des Monats", every: "jeden"},
+	WeekdayField:     {name: "Wochentag", every: "jeden"},
+	MonthField:       {name: "Monat", every: "jeden"},
+	YearField:        {name: "Jahr", every: "jedes"},
+	YearDayField:     {name: "Tag des Jahres", every: "jeden"},
+	ISOWeekField:     {name: "Kalenderwoche", every: "jede"},
+}
+
+var germanOccurrences = [...]string{"letzten", "ersten", "zweiten", "dritten", "vierten", "fünften"}
+
+func (german) Month(mon time.Month) string {
+	return germanMonths[mon-1]
+}
+
+func (german) Weekday(wd time.Weekday) string {
+	return germanWeekdays[wd]
+}
+
+func (german) Clock(h int, min int, s int, ms int) string {
+	return clock(h, min, s, ms)
+}
+
+func (german) Every(f CronField, step int) string {
+	if step == 1 {
+		return germanFields[f].every + " " + germanFields[f].name
+	}
+	return germanFields[f].every + " " + strconv.Itoa(step) + ". " + germanFields[f].name
+}
+
+func (loc german) Range(f CronField, step int, from string, to string) string {
+	return loc.Every(f, step) + " von " + from + " bis " + to
+}
+
+func (german) Values(f CronField, values []string) string {
+	switch f {
+	case DayField:
+		ordinals := make([]string, len(values))
+		for i, v := range values {
+			ordinals[i] = v + "."
+		}
+		return "am " + enumeration(ordinals, ", ", " und ") + " Tag des Monats"
+	case WeekdayField:
+		return "am " + enumeration(values, ", ", " und ")
+	case MonthField:
+		return "im " + enumeration(values, ", ", " und ")
+	case YearField:
+		return "im Jahr " + enumeration(values, ", ", " und ")
+	case YearDayField:
+		ordinals := make([]string, len(values))
+		for i, v := range values {
+			ordinals[i] = v + "."
+		}
+		return "am " + enumeration(ordinals, ", ", " und ") + " Tag des Jahres"
+	case ISOWeekField:
+		return "in der Kalenderwoche " + enumeration(values, ", ", " und ")
+	}
+	return germanFields[f].name + " " + enumeration(values, ", ", " und ")
+}
+
+func (german) LastDay(before int) string {
+	switch before {
+	case 0:
+		return "am letzten Tag des Monats"
+	case 1:
+		return "am vorletzten Tag des Monats"
+	}
+	return "am " + strconv.Itoa(before) + ". Tag vor dem letzten Tag des Monats"
+}
+
+func (german) NearestWeekday(d int) string {
+	if d == 0 {
+		return "am letzten Werktag des Monats"
+	}
+	return "am Werktag, der dem " + strconv.Itoa(d) + ". Tag des Monats am nächsten liegt"
+}
+
+func (german) Weeks(weeks int, anchor string) string {
+	if weeks == 1 {
+		return "jede Woche ab " + anchor
+	}
+	return "jede " + strconv.Itoa(weeks) + ". Woche ab " + anchor
+}
+
+func (german) NthWeekday(weekday string, n int) string {
+	return "am " + germanOccurrences[n] + " " + weekday + " des Monats"
+}
+
+func (german) Sentence(d Description) string {
+	head := strings.Join(d.Time, ", ")
+	if d.Clock != "" {
+		head = "um " + d.Clock
+	}
+	conjunction := " und "
+	if d.AnyDay {
+		conjunction = " oder "
+	}
+	return capitalize(joinParts(" ", head, joinParts(conjunction, d.Days, d.Weekdays), d.YearDays, d.Weeks, d.ISOWeeks, d.Months, d.Years)) + "."
+}
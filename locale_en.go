@@ -0,0 +1,134 @@
+package schedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// English is the Locale used to describe CronExpressions in English.
+var English Locale = english{}
+
+type english struct{}
+
+var englishFields = [...]string{
+	MillisecondField: "millisecond",
+	SecondField:      "second",
+	MinuteField:      "minute",
+	HourField:        "hour",
+	DayField:         "day-of-month",
+	WeekdayField:     "day-of-week",
+	MonthField:       "month",
+	YearField:        "year",
+	YearDayField:     "day-of-year",
+	ISOWeekField:     "ISO week",
+}
+
+var englishOccurrences = [...]string{"last", "first", "second", "third", "fourth", "fifth"}
+
+func (english) Month(mon time.Month) string {
+	return mon.String()
+}
+
+func (english) Weekday(wd time.Weekday) string {
+	return wd.String()
+}
+
+func (english) Clock(h int, min int, s int, ms int) string {
+	return clock(h, min, s, ms)
+}
+
+func (english) Every(f CronField, step int) string {
+	if step == 1 {
+		return "every " + englishFields[f]
+	}
+	return "every " + englishOrdinal(step) + " " + englishFields[f]
+}
+
+func (loc english) Range(f CronField, step int, from string, to string) string {
+	return loc.Every(f, step) + " from " + from + " through " + to
+}
+
+func (english) Values(f CronField, values []string) string {
+	list := englishEnumeration(values)
+	if f == WeekdayField || f == MonthField {
+		return list
+	}
+	return englishFields[f] + " " + list
+}
+
+func (english) LastDay(before int) string {
+	if before == 0 {
+		return "the last day of the month"
+	}
+	return "the " + englishOrdinal(before) + " day before the last day of the month"
+}
+
+func (english) NearestWeekday(d int) string {
+	if d == 0 {
+		return "the last weekday of the month"
+	}
+	return "the weekday nearest to day-of-month " + strconv.Itoa(d)
+}
+
+func (english) Weeks(weeks int, anchor string) string {
+	if weeks == 1 {
+		return "every week starting " + anchor
+	}
+	return "every " + englishOrdinal(weeks) + " week starting " + anchor
+}
+
+func (english) NthWeekday(weekday string, n int) string {
+	return "the " + englishOccurrences[n] + " " + weekday + " of the month"
+}
+
+func (english) Sentence(d Description) string {
+	var sb strings.Builder
+	sb.WriteString("At ")
+	if d.Clock != "" {
+		sb.WriteString(d.Clock)
+	} else {
+		sb.WriteString(strings.Join(d.Time, " past "))
+	}
+	conjunction := " and on "
+	if d.AnyDay {
+		conjunction = " or on "
+	}
+	if days := joinParts(" and on ", joinParts(conjunction, d.Days, d.Weekdays), d.YearDays); days != "" {
+		sb.WriteString(" on ")
+		sb.WriteString(days)
+	}
+	if d.Weeks != "" {
+		sb.WriteString(" ")
+		sb.WriteString(d.Weeks)
+	}
+	for _, part := range [...]string{d.ISOWeeks, d.Months, d.Years} {
+		if part != "" {
+			sb.WriteString(" in ")
+			sb.WriteString(part)
+		}
+	}
+	sb.WriteString(".")
+	return sb.String()
+}
+
+func englishEnumeration(names []string) string {
+	if len(names) == 2 {
+		return names[0] + " and " + names[1]
+	}
+	return enumeration(names, ", ", ", and ")
+}
+
+func englishOrdinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return strconv.Itoa(n) + suffix
+}
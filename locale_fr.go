@@ -0,0 +1,115 @@
+package schedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// French is the Locale used to describe CronExpressions in French.
+var French Locale = french{}
+
+type french struct{}
+
+var frenchMonths = [...]string{
+	"janvier", "février", "mars", "avril", "mai", "juin",
+	"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+}
+
+var frenchWeekdays = [...]string{
+	"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi",
+}
+
+// frenchFields holds the phrasing of each field, respecting its gender and number.
+var frenchFields = [...]struct {
+	every  string
+	steps  string
+	plural string
+	value  string
+	values string
+	suffix string
+}{
+	MillisecondField: {every: "chaque milliseconde", steps: "toutes les", plural: "millisecondes", value: "à la milliseconde ", values: "aux millisecondes "},
+	SecondField:      {every: "chaque seconde", steps: "toutes les", plural: "secondes", value: "à la seconde ", values: "aux secondes "},
+	MinuteField:      {every: "chaque minute", steps: "toutes les", plural: "minutes", value: "à la minute ", values: "aux minutes "},
+	HourField:        {every: "chaque heure", steps: "toutes les", plural: "heures", value: "à l'heure ", values: "aux heures "},
+	DayField:         {every: "chaque jour du mois", steps: "tous les", plural: "jours du mois", value: "le jour ", values: "les jours ", suffix: " du mois"},
+	WeekdayField:     {every: "chaque jour de la semaine", steps: "tous les", plural: "jours de la semaine", value: "le ", values: "le "},
+	MonthField:       {every: "chaque mois", steps: "tous les", plural: "mois", value: "en ", values: "en "},
+	YearField:        {every: "chaque année", steps: "tous les", plural: "ans", value: "en ", values: "en "},
+	YearDayField:     {every: "chaque jour de l'année", steps: "tous les", plural: "jours de l'année", value: "le jour ", values: "les jours ", suffix: " de l'année"},
+	ISOWeekField:     {every: "chaque semaine ISO", steps: "toutes les", plural: "semaines ISO", value: "la semaine ISO ", values: "les semaines ISO "},
+}
+
+var frenchOccurrences = [...]string{"dernier", "premier", "deuxième", "troisième", "quatrième", "cinquième"}
+
+func (french) Month(mon time.Month) string {
+	return frenchMonths[mon-1]
+}
+
+func (french) Weekday(wd time.Weekday) string {
+	return frenchWeekdays[wd]
+}
+
+func (french) Clock(h int, min int, s int, ms int) string {
+	return clock(h, min, s, ms)
+}
+
+func (french) Every(f CronField, step int) string {
+	if step == 1 {
+		return frenchFields[f].every
+	}
+	return frenchFields[f].steps + " " + strconv.Itoa(step) + " " + frenchFields[f].plural
+}
+
+func (loc french) Range(f CronField, step int, from string, to string) string {
+	return loc.Every(f, step) + " de " + from + " à " + to
+}
+
+func (french) Values(f CronField, values []string) string {
+	prefix := frenchFields[f].value
+	if len(values) > 1 {
+		prefix = frenchFields[f].values
+	}
+	return prefix + enumeration(values, ", ", " et ") + frenchFields[f].suffix
+}
+
+func (french) LastDay(before int) string {
+	switch before {
+	case 0:
+		return "le dernier jour du mois"
+	case 1:
+		return "l'avant-dernier jour du mois"
+	}
+	return "le " + strconv.Itoa(before) + "e jour avant le dernier jour du mois"
+}
+
+func (french) NearestWeekday(d int) string {
+	if d == 0 {
+		return "le dernier jour ouvré du mois"
+	}
+	return "le jour ouvré le plus proche du " + strconv.Itoa(d) + " du mois"
+}
+
+func (french) Weeks(weeks int, anchor string) string {
+	if weeks == 1 {
+		return "chaque semaine à partir du " + anchor
+	}
+	return "toutes les " + strconv.Itoa(weeks) + " semaines à partir du " + anchor
+}
+
+func (french) NthWeekday(weekday string, n int) string {
+	return "le " + frenchOccurrences[n] + " " + weekday + " du mois"
+}
+
+func (french) Sentence(d Description) string {
+	head := strings.Join(d.Time, ", ")
+	if d.Clock != "" {
+		head = "à " + d.Clock
+	}
+	conjunction := " et "
+	if d.AnyDay {
+		conjunction = " ou "
+	}
+	return capitalize(joinParts(" ", head, joinParts(conjunction, d.Days, d.Weekdays), d.YearDays, d.Weeks, d.ISOWeeks, d.Months, d.Years)) + "."
+}
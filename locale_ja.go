@@ -0,0 +1,109 @@
+package schedule
+
+import (
+	"strconv"
+	"time"
+)
+
+// Japanese is the Locale used to describe CronExpressions in Japanese.
+var Japanese Locale = japanese{}
+
+type japanese struct{}
+
+var japaneseWeekdays = [...]string{
+	"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日",
+}
+
+// japaneseFields holds the counters used by each field.
+var japaneseFields = [...]struct {
+	every string
+	step  string
+	value string
+}{
+	MillisecondField: {every: "毎ミリ秒", step: "ミリ秒", value: "ミリ秒"},
+	SecondField:      {every: "毎秒", step: "秒", value: "秒"},
+	MinuteField:      {every: "毎分", step: "分", value: "分"},
+	HourField:        {every: "毎時", step: "時間", value: "時"},
+	DayField:         {every: "毎日", step: "日", value: "日"},
+	WeekdayField:     {every: "毎日", step: "日"},
+	MonthField:       {every: "毎月", step: "か月"},
+	YearField:        {every: "毎年", step: "年", value: "年"},
+	YearDayField:     {every: "毎日", step: "日", value: "日目"},
+	ISOWeekField:     {every: "毎週", step: "週", value: "週目"},
+}
+
+func (japanese) Month(mon time.Month) string {
+	return strconv.Itoa(int(mon)) + "月"
+}
+
+func (japanese) Weekday(wd time.Weekday) string {
+	return japaneseWeekdays[wd]
+}
+
+func (japanese) Clock(h int, min int, s int, ms int) string {
+	return clock(h, min, s, ms)
+}
+
+func (japanese) Every(f CronField, step int) string {
+	if step == 1 {
+		return japaneseFields[f].every
+	}
+	return strconv.Itoa(step) + japaneseFields[f].step + "ごと"
+}
+
+func (loc japanese) Range(f CronField, step int, from string, to string) string {
+	text := from + japaneseFields[f].value + "から" + to + japaneseFields[f].value + "まで"
+	if step > 1 {
+		text += "の" + loc.Every(f, step)
+	}
+	return text
+}
+
+func (japanese) Values(f CronField, values []string) string {
+	counted := make([]string, len(values))
+	for i, v := range values {
+		counted[i] = v + japaneseFields[f].value
+	}
+	return enumeration(counted, "、", "と")
+}
+
+func (japanese) LastDay(before int) string {
+	if before == 0 {
+		return "月末"
+	}
+	return "月末の" + strconv.Itoa(before) + "日前"
+}
+
+func (japanese) NearestWeekday(d int) string {
+	if d == 0 {
+		return "月末の平日"
+	}
+	return strconv.Itoa(d) + "日に最も近い平日"
+}
+
+func (japanese) Weeks(weeks int, anchor string) string {
+	if weeks == 1 {
+		return anchor + "から毎週"
+	}
+	return anchor + "から" + strconv.Itoa(weeks) + "週間ごと"
+}
+
+func (japanese) NthWeekday(weekday string, n int) string {
+	if n == 0 {
+		return "最終" + weekday
+	}
+	return "第" + strconv.Itoa(n) + weekday
+}
+
+func (japanese) Sentence(d Description) string {
+	// Japanese orders the parts from the coarsest to the finest.
+	at := d.Clock
+	for _, part := range d.Time {
+		at = joinParts("の", part, at)
+	}
+	conjunction := "と"
+	if d.AnyDay {
+		conjunction = "または"
+	}
+	return joinParts("の", d.Years, d.YearDays, d.Months, d.ISOWeeks, d.Weeks, joinParts(conjunction, d.Days, d.Weekdays), at) + "。"
+}
@@ -0,0 +1,131 @@
+package schedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Portuguese is the Locale used to describe CronExpressions in Portuguese.
+var Portuguese Locale = portuguese{}
+
+type portuguese struct{}
+
+var portugueseMonths = [...]string{
+	"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+	"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+}
+
+var portugueseWeekdays = [...]string{
+	"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado",
+}
+
+// portugueseFields holds the phrasing of each field, respecting its gender and number.
+var portugueseFields = [...]struct {
+	every  string
+	plural string
+	value  string
+	values string
+	suffix string
+}{
+	MillisecondField: {every: "a cada milissegundo", plural: "milissegundos", value: "no milissegundo ", values: "nos milissegundos "},
+	SecondField:      {every: "a cada segundo", plural: "segundos", value: "no segundo ", values: "nos segundos "},
+	MinuteField:      {every: "a cada minuto", plural: "minutos", value: "no minuto ", values: "nos minutos "},
+	HourField:        {every: "a cada hora", plural: "horas", value: "na hora ", values: "nas horas "},
+	DayField:         {every: "a cada dia do mês", plural: "dias do mês", value: "no dia ", values: "nos dias ", suffix: " do mês"},
+	WeekdayField:     {every: "a cada dia da semana", plural: "dias da semana"},
+	MonthField:       {every: "a cada mês", plural: "meses", value: "em ", values: "em "},
+	YearField:        {every: "a cada ano", plural: "anos", value: "em ", values: "em "},
+	YearDayField:     {every: "a cada dia do ano", plural: "dias do ano", value: "no dia ", values: "nos dias ", suffix: " do ano"},
+	ISOWeekField:     {every: "a cada semana ISO", plural: "semanas ISO", value: "na semana ISO ", values: "nas semanas ISO "},
+}
+
+// portugueseOccurrences holds the ordinals without their gender ending.
+var portugueseOccurrences = [...]string{"últim", "primeir", "segund", "terceir", "quart", "quint"}
+
+func (portuguese) Month(mon time.Month) string {
+	return portugueseMonths[mon-1]
+}
+
+func (portuguese) Weekday(wd time.Weekday) string {
+	return portugueseWeekdays[wd]
+}
+
+func (portuguese) Clock(h int, min int, s int, ms int) string {
+	return clock(h, min, s, ms)
+}
+
+func (portuguese) Every(f CronField, step int) string {
+	if step == 1 {
+		return portugueseFields[f].every
+	}
+	return "a cada " + strconv.Itoa(step) + " " + portugueseFields[f].plural
+}
+
+func (loc portuguese) Range(f CronField, step int, from string, to string) string {
+	return loc.Every(f, step) + " de " + from + " a " + to
+}
+
+func (portuguese) Values(f CronField, values []string) string {
+	if f == WeekdayField {
+		// the weekdays ending in "-feira" are feminine, the weekend days are masculine.
+		articled := make([]string, len(values))
+		for i, v := range values {
+			articled[i] = "no " + v
+			if strings.HasSuffix(v, "-feira") {
+				articled[i] = "na " + v
+			}
+		}
+		return enumeration(articled, ", ", " e ")
+	}
+
+	prefix := portugueseFields[f].value
+	if len(values) > 1 {
+		prefix = portugueseFields[f].values
+	}
+	return prefix + enumeration(values, ", ", " e ") + portugueseFields[f].suffix
+}
+
+func (portuguese) LastDay(before int) string {
+	switch before {
+	case 0:
+		return "no último dia do mês"
+	case 1:
+		return "no penúltimo dia do mês"
+	}
+	return "no " + strconv.Itoa(before) + ".º dia antes do último dia do mês"
+}
+
+func (portuguese) NearestWeekday(d int) string {
+	if d == 0 {
+		return "no último dia útil do mês"
+	}
+	return "no dia útil mais próximo do dia " + strconv.Itoa(d) + " do mês"
+}
+
+func (portuguese) Weeks(weeks int, anchor string) string {
+	if weeks == 1 {
+		return "a cada semana a partir de " + anchor
+	}
+	return "a cada " + strconv.Itoa(weeks) + " semanas a partir de " + anchor
+}
+
+func (portuguese) NthWeekday(weekday string, n int) string {
+	// the weekdays ending in "-feira" are feminine, the weekend days are masculine.
+	if strings.HasSuffix(weekday, "-feira") {
+		return "na " + portugueseOccurrences[n] + "a " + weekday + " do mês"
+	}
+	return "no " + portugueseOccurrences[n] + "o " + weekday + " do mês"
+}
+
+func (portuguese) Sentence(d Description) string {
+	head := strings.Join(d.Time, ", ")
+	if d.Clock != "" {
+		head = "às " + d.Clock
+	}
+	conjunction := " e "
+	if d.AnyDay {
+		conjunction = " ou "
+	}
+	return capitalize(joinParts(" ", head, joinParts(conjunction, d.Days, d.Weekdays), d.YearDays, d.Weeks, d.ISOWeeks, d.Months, d.Years)) + "."
+}
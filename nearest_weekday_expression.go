@@ -0,0 +1,62 @@
+package schedule
+
+import "time"
+
+// NearestWeekdayExpression is the struct used to create nearest weekday (Monday to Friday) expressions.
+// The nearest weekday never belongs to a different month than its day.
+type NearestWeekdayExpression struct {
+	// d is the day of the month, 0 being its last day.
+	d int
+}
+
+// NearestWeekday is an expression that generates the weekday nearest to the provided day of every month.
+// Example: NearestWeekday(15)
+//
+//   - Saturday 15th = Friday 14th
+//   - Sunday 15th = Monday 16th
+//   - Saturday 1st = Monday 3rd
+func NearestWeekday(d int) *NearestWeekdayExpression {
+	validateDay(d)
+	return &NearestWeekdayExpression{d: d}
+}
+
+// NearestWeekdayToLastDay is an expression that generates the last weekday of every month.
+func NearestWeekdayToLastDay() *NearestWeekdayExpression {
+	return &NearestWeekdayExpression{}
+}
+
+// NextDay allows retrieval of the next day from this expression, within the provided month.
+// It behaves as the Next function of IteratorExpressions, the only value of this expression being its last one.
+// Months that do not contain the expression's day produce a day they do not contain.
+func (exp *NearestWeekdayExpression) NextDay(am *AttunedMonth, from int, inc bool) (int, bool) {
+	return exp.day(am), true
+}
+
+// ContainsDay verifies if the provided day of the month belongs to this expression.
+func (exp *NearestWeekdayExpression) ContainsDay(am *AttunedMonth, d int) bool {
+	return d == exp.day(am)
+}
+
+func (exp *NearestWeekdayExpression) day(am *AttunedMonth) int {
+	d := exp.d
+	if d == 0 {
+		d = am.MonthLastDay()
+	}
+	if !am.Contains(d) {
+		return d
+	}
+
+	switch am.WeekDay(d) {
+	case time.Saturday:
+		if d == 1 {
+			return d + 2
+		}
+		return d - 1
+	case time.Sunday:
+		if am.IsMonthLastDay(d) {
+			return d - 2
+		}
+		return d + 1
+	}
+	return d
+}
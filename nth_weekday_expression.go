@@ -0,0 +1,47 @@
+package schedule
+
+import "time"
+
+// NthWeekdayExpression is the struct used to create nth weekday of the month expressions.
+type NthWeekdayExpression struct {
+	wd time.Weekday
+	// n is the occurrence of the weekday in the month, 0 being the last one.
+	n int
+}
+
+// NthWeekday is an expression that generates the nth occurrence of the provided weekday in every month.
+// Example: NthWeekday(time.Tuesday, 2) generates the second Tuesday of every month.
+func NthWeekday(wd time.Weekday, n int) *NthWeekdayExpression {
+	validateWeekday(int(wd))
+	if n < 1 || n > 5 {
+		panic(InvalidOccurrenceError.Error())
+	}
+	return &NthWeekdayExpression{wd: wd, n: n}
+}
+
+// LastWeekday is an expression that generates the last occurrence of the provided weekday in every month.
+// Example: LastWeekday(time.Friday) generates the last Friday of every month.
+func LastWeekday(wd time.Weekday) *NthWeekdayExpression {
+	validateWeekday(int(wd))
+	return &NthWeekdayExpression{wd: wd}
+}
+
+// NextDay allows retrieval of the next day from this expression, within the provided month.
+// It behaves as the Next function of IteratorExpressions, the only value of this expression being its last one.
+// Months without a fifth occurrence of the weekday produce a day they do not contain.
+func (exp *NthWeekdayExpression) NextDay(am *AttunedMonth, from int, inc bool) (int, bool) {
+	return exp.day(am), true
+}
+
+// ContainsDay verifies if the provided day of the month belongs to this expression.
+func (exp *NthWeekdayExpression) ContainsDay(am *AttunedMonth, d int) bool {
+	return d == exp.day(am)
+}
+
+func (exp *NthWeekdayExpression) day(am *AttunedMonth) int {
+	if exp.n == 0 {
+		last := am.MonthLastDay()
+		return last - (int(am.WeekDay(last))-int(exp.wd)+7)%7
+	}
+	return 1 + (int(exp.wd)-int(am.WeekDay(1))+7)%7 + (exp.n-1)*7
+}
@@ -0,0 +1,142 @@
+package schedule
+
+import "time"
+
+// Between returns the dates produced by the referenced CronExpression from the provided date (inclusive) until
+// to (exclusive), in the location of from. At most limit dates are returned.
+// Dates skipped by daylight saving time transitions are not included.
+func (crn *CronExpression) Between(from time.Time, to time.Time, limit int) []time.Time {
+	var dates []time.Time
+	if limit < 1 {
+		return dates
+	}
+	crn.occurrences(from, to, func(t time.Time) bool {
+		dates = append(dates, t)
+		return len(dates) < limit
+	})
+	return dates
+}
+
+// Count returns the number of dates produced by the referenced CronExpression from the provided date (inclusive)
+// until to (exclusive), in the location of from.
+// The dates of each day are counted from the size of the time fields, only days of daylight saving time transitions
+// are iterated, the same way Between does.
+func (crn *CronExpression) Count(from time.Time, to time.Time) int {
+	crn.initialize()
+	count := 0
+	if !from.Before(to) {
+		return count
+	}
+
+	perDay := size(crn.hours, hourUnit) * size(crn.minutes, minuteUnit) *
+		size(crn.seconds, secondUnit) * size(crn.milliseconds, millisecondUnit)
+	loc := from.Location()
+	to = to.In(loc)
+	am := NewAttunedMonth(int(from.Month()), from.Year())
+	for day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc); day.Before(to); {
+		y, mon, d := day.Date()
+		next := time.Date(y, mon, d+1, 0, 0, 0, 0, loc)
+		am.UpdateMonthYear(int(mon), y)
+		if contains(crn.months, int(mon)) && contains(crn.years, y) && crn.matchesDay(am, d) {
+			if !isRegularDay(day, next) {
+				crn.occurrences(latest(day, from), earliest(next, to), func(time.Time) bool {
+					count++
+					return true
+				})
+			} else {
+				count += perDay
+				if from.After(day) {
+					count -= crn.countBefore(from)
+				}
+				if to.Before(next) {
+					count -= perDay - crn.countBefore(to)
+				}
+			}
+		}
+		day = next
+	}
+	return count
+}
+
+// occurrences produces the dates from the provided date (inclusive) until to (exclusive), while yield returns true.
+func (crn *CronExpression) occurrences(from time.Time, to time.Time, yield func(time.Time) bool) {
+	for t := range crn.From(from.Add(-time.Millisecond)) {
+		if !t.Before(to) {
+			return
+		}
+		if !t.Before(from) && !yield(t) {
+			return
+		}
+	}
+}
+
+// countBefore counts the dates produced on the day of the provided date, before it.
+// The time fields are ordered like the dates they produce, so each field counts its values below the one of the date,
+// multiplied by the size of the finer fields.
+func (crn *CronExpression) countBefore(t time.Time) int {
+	fields := [...]struct {
+		exp Expression
+		u   unit
+		v   int
+	}{
+		{crn.hours, hourUnit, t.Hour()},
+		{crn.minutes, minuteUnit, t.Minute()},
+		{crn.seconds, secondUnit, t.Second()},
+		{crn.milliseconds, millisecondUnit, t.Nanosecond() / int(time.Millisecond)},
+	}
+	count, combinations := 0, 1
+	for i := len(fields) - 1; i >= 0; i-- {
+		f := fields[i]
+		within := count
+		if i == len(fields)-1 {
+			// a date between two milliseconds follows the one it truncates to.
+			within = 0
+			if t.Nanosecond()%int(time.Millisecond) != 0 {
+				within = 1
+			}
+		}
+		count = sizeBelow(f.exp, f.u, f.v) * combinations
+		if contains(f.exp, f.v) {
+			count += within
+		}
+		combinations *= size(f.exp, f.u)
+	}
+	return count
+}
+
+// size counts the values of the expression within the unit.
+func size(exp Expression, u unit) int {
+	return sizeBelow(exp, u, u.max+1)
+}
+
+// sizeBelow counts the values of the expression within the unit, below the provided value.
+func sizeBelow(exp Expression, u unit, v int) int {
+	count := 0
+	for i := u.min; i < v; i++ {
+		if contains(exp, i) {
+			count++
+		}
+	}
+	return count
+}
+
+// isRegularDay verifies if the day starting at the provided date lasts all of its hours in a single offset.
+func isRegularDay(day time.Time, next time.Time) bool {
+	_, offset := day.Zone()
+	_, nextOffset := next.Add(-time.Nanosecond).Zone()
+	return day.Hour() == 0 && day.Minute() == 0 && offset == nextOffset
+}
+
+func earliest(t1 time.Time, t2 time.Time) time.Time {
+	if t2.Before(t1) {
+		return t2
+	}
+	return t1
+}
+
+func latest(t1 time.Time, t2 time.Time) time.Time {
+	if t2.After(t1) {
+		return t2
+	}
+	return t1
+}
@@ -0,0 +1,513 @@
+package schedule
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxListValues limits the amount of values a list field may expand to.
+const maxListValues = 1000
+
+// field is used to describe how a textual cron field maps to its unit.
+type field struct {
+	unit
+	// offset is added to the unit's values in their textual form.
+	offset int
+	// placeholder allows the "?" no specific value character.
+	placeholder bool
+}
+
+// token is a whitespace separated part of a textual cron expression.
+type token struct {
+	text string
+	pos  int
+}
+
+// fieldRange is a single comma separated item of a textual cron field.
+type fieldRange struct {
+	x      int
+	y      int
+	step   int
+	single bool
+	// cyclic ranges wrap around the end of their unit.
+	cyclic bool
+}
+
+var standardFields = [...]field{
+	{unit: minuteUnit},
+	{unit: hourUnit},
+	{unit: dayUnit},
+	{unit: monthUnit},
+	{unit: weekdayUnit},
+}
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var quartzFields = [...]field{
+	{unit: secondUnit},
+	{unit: minuteUnit},
+	{unit: hourUnit},
+	{unit: dayUnit, placeholder: true},
+	{unit: monthUnit},
+	{unit: weekdayUnit, offset: 1, placeholder: true},
+	{unit: yearUnit},
+}
+
+// Parse creates a new CronExpression from a standard 5-field crontab expression.
+// The fields are, in order: minute, hour, day-of-month, month and day-of-week.
+// Each field accepts "*", single values, ranges ("1-5"), steps ("*/15", "0-20/2", "5/10") and comma separated lists.
+// Months and weekdays also accept their English names, complete or abbreviated to three letters ("JAN-MAR", "mon,wed,fri"),
+// and 7 is accepted as Sunday.
+// Ranges of hours, months and weekdays may wrap around the end of their unit ("22-2", "5-1"), producing CyclicExpressions.
+// The predefined macros @yearly (or @annually), @monthly, @weekly, @daily (or @midnight) and @hourly are also accepted.
+// As in Vixie cron, dates satisfying either the day-of-month or the day-of-week are produced (see DaysOrWeekdays),
+// unless either of them starts with "*", in which case dates need to satisfy both.
+// Example: Parse("*/15 9-17 * * 1-5"):
+//
+//	Cron().OnMinutes(Between(0, 45).Every(15)).OnHours(Between(9, 17)).OnWeekdays(Between(1, 5))
+func Parse(spec string) (*CronExpression, error) {
+	tokens := tokenize(spec)
+	if len(tokens) > 0 && strings.HasPrefix(tokens[0].text, "@") {
+		return parseMacro(tokens)
+	}
+	if err := checkFieldCount(spec, tokens, len(standardFields), len(standardFields)); err != nil {
+		return nil, err
+	}
+
+	exps, err := parseFields(tokens, standardFields[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Vixie cron chooses how to combine the days from the text of the fields rather than from their values.
+	matching := DaysOrWeekdays
+	if strings.HasPrefix(tokens[2].text, "*") || strings.HasPrefix(tokens[4].text, "*") {
+		matching = DaysAndWeekdays
+	} else if isAll(exps[2], dayUnit) || isAll(exps[4], weekdayUnit) {
+		// either of them is satisfied by every day, as when both are "*".
+		exps[2], exps[4] = BetweenDays(1, 31), BetweenWeekdays(time.Sunday, time.Saturday)
+	}
+
+	return Cron().
+		OnMinutes(exps[0]).
+		OnHours(exps[1]).
+		OnDays(exps[2]).
+		OnMonths(exps[3]).
+		OnWeekdays(exps[4]).
+		MatchDays(matching), nil
+}
+
+// ParseQuartz creates a new CronExpression from a Quartz 6-field or 7-field cron expression.
+// The fields are, in order: second, minute, hour, day-of-month, month, day-of-week and the optional year.
+// Following Quartz, day-of-week values range from 1 (Sunday) to 7 (Saturday),
+// and "?" may be used in the day-of-month or day-of-week fields to specify no particular value.
+// As in Parse, months and weekdays also accept their English names.
+// Example: ParseQuartz("0 15 10 ? * 2-6 2026"):
+//
+//	Cron().OnSeconds(0).OnMinutes(15).OnHours(10).OnWeekdays(Between(1, 5)).OnYears(2026)
+func ParseQuartz(spec string) (*CronExpression, error) {
+	tokens := tokenize(spec)
+	if err := checkFieldCount(spec, tokens, len(quartzFields)-1, len(quartzFields)); err != nil {
+		return nil, err
+	}
+
+	exps, err := parseFields(tokens, quartzFields[:len(tokens)])
+	if err != nil {
+		return nil, err
+	}
+
+	crn := Cron().
+		OnSeconds(exps[0]).
+		OnMinutes(exps[1]).
+		OnHours(exps[2]).
+		OnDays(exps[3]).
+		OnMonths(exps[4]).
+		OnWeekdays(exps[5])
+	if len(exps) == len(quartzFields) {
+		crn.OnYears(exps[6])
+	}
+	return crn, nil
+}
+
+// ParseSchedule creates a new Schedule from a standard crontab expression, a predefined macro or an interval.
+// Intervals are written as "@every <duration>", using the format accepted by time.ParseDuration.
+// Cron expressions produce dates as the As function does.
+// Example: ParseSchedule("@every 1h30m"):
+//
+//	date = time.Now().Add(time.Hour + time.Minute*30);
+//	date = date.Add(time.Hour + time.Minute*30);
+//	...
+func ParseSchedule(spec string) (*Schedule, error) {
+	tokens := tokenize(spec)
+	if len(tokens) > 0 && tokens[0].text == "@every" {
+		d, err := parseInterval(spec, tokens)
+		if err != nil {
+			return nil, err
+		}
+		return interval(time.Now().Add(d), d), nil
+	}
+
+	crn, err := Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	return NewAs(crn)
+}
+
+// parseText parses either a standard crontab expression, a macro or a Quartz expression, depending on its amount of fields.
+func parseText(spec string) (*CronExpression, error) {
+	tokens := tokenize(spec)
+	if len(tokens) > len(standardFields) && !strings.HasPrefix(tokens[0].text, "@") {
+		return ParseQuartz(spec)
+	}
+	return Parse(spec)
+}
+
+func parseMacro(tokens []token) (*CronExpression, error) {
+	if tokens[0].text == "@every" {
+		return nil, &ErrorParse{Position: tokens[0].pos, Reason: "intervals are only supported by ParseSchedule"}
+	}
+	spec, found := macros[tokens[0].text]
+	if !found {
+		return nil, &ErrorParse{Position: tokens[0].pos, Reason: "unknown macro " + strconv.Quote(tokens[0].text)}
+	}
+	if len(tokens) > 1 {
+		return nil, &ErrorParse{Position: tokens[1].pos, Reason: "unexpected value after macro"}
+	}
+	return Parse(spec)
+}
+
+func parseInterval(spec string, tokens []token) (time.Duration, error) {
+	if len(tokens) != 2 {
+		return 0, checkFieldCount(spec, tokens, 2, 2)
+	}
+	d, err := time.ParseDuration(tokens[1].text)
+	if err != nil || d <= 0 {
+		return 0, &ErrorParse{Position: tokens[1].pos, Reason: "invalid interval " + strconv.Quote(tokens[1].text)}
+	}
+	return d, nil
+}
+
+func tokenize(spec string) []token {
+	var tokens []token
+	start := -1
+	for i := 0; i <= len(spec); i++ {
+		if i == len(spec) || spec[i] == ' ' || spec[i] == '\t' {
+			if start >= 0 {
+				tokens = append(tokens, token{text: spec[start:i], pos: start})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+
+	return tokens
+}
+
+func checkFieldCount(spec string, tokens []token, min int, max int) error {
+	if len(tokens) >= min && len(tokens) <= max {
+		return nil
+	}
+
+	expected := strconv.Itoa(min)
+	if min != max {
+		expected += " or " + strconv.Itoa(max)
+	}
+	err := &ErrorParse{
+		Position: len(spec),
+		Reason:   "expected " + expected + " fields, found " + strconv.Itoa(len(tokens)),
+	}
+	if len(tokens) > max {
+		err.Position = tokens[max].pos
+	}
+	return err
+}
+
+func parseFields(tokens []token, fields []field) ([]Expression, error) {
+	exps := make([]Expression, len(fields))
+	for i, f := range fields {
+		exp, err := f.parse(tokens[i])
+		if err != nil {
+			return nil, err
+		}
+		exps[i] = exp
+	}
+	return exps, nil
+}
+
+func (f field) parse(tok token) (Expression, error) {
+	if f.unit == dayUnit && strings.HasSuffix(tok.text, "W") {
+		return f.parseNearestWeekday(tok)
+	}
+	if f.unit == dayUnit && strings.HasPrefix(tok.text, "L") {
+		return f.parseLastDay(tok)
+	}
+	if f.unit == weekdayUnit && (strings.Contains(tok.text, "#") || strings.HasSuffix(tok.text, "L")) {
+		return f.parseNthWeekday(tok)
+	}
+	items := strings.Split(tok.text, ",")
+	pos := tok.pos
+	var values []int
+	for _, item := range items {
+		r, err := f.parseRange(item, pos)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 1 {
+			return f.expression(r), nil
+		}
+		if len(values)+r.count(f.unit) > maxListValues {
+			return nil, f.error(pos, "too many values in list")
+		}
+		values = r.appendValues(values, f.unit)
+		pos += len(item) + 1
+	}
+	return List(unique(values)), nil
+}
+
+// parseLastDay parses the last day of the month ("L"), optionally preceded by an amount of days ("L-3").
+func (f field) parseLastDay(tok token) (Expression, error) {
+	if tok.text == "L" {
+		return LastDay(), nil
+	}
+	beforeText, found := strings.CutPrefix(tok.text, "L-")
+	if !found {
+		return nil, f.error(tok.pos+1, "invalid value "+strconv.Quote(tok.text))
+	}
+	before, bad, ok := parseNumber(beforeText)
+	if !ok || before > 30 {
+		return nil, f.error(tok.pos+2+bad, "invalid offset "+strconv.Quote(beforeText))
+	}
+	return LastDay().Before(before), nil
+}
+
+// parseNearestWeekday parses the weekday nearest to a day of the month ("15W"), or the last weekday of the month ("LW").
+func (f field) parseNearestWeekday(tok token) (Expression, error) {
+	if tok.text == "LW" {
+		return NearestWeekdayToLastDay(), nil
+	}
+	d, err := f.parseValue(strings.TrimSuffix(tok.text, "W"), tok.pos)
+	if err != nil {
+		return nil, err
+	}
+	return NearestWeekday(d), nil
+}
+
+// parseNthWeekday parses the nth occurrence of a weekday in the month ("2#3"), or its last occurrence ("5L").
+// As in Quartz, "L" on its own is the last day of the week, Saturday. Crontab weekdays have no such value.
+func (f field) parseNthWeekday(tok token) (Expression, error) {
+	if tok.text == "L" {
+		if f.sundaySeven() {
+			return nil, f.error(tok.pos, "missing weekday before \"L\"")
+		}
+		return time.Saturday, nil
+	}
+	if wdText, found := strings.CutSuffix(tok.text, "L"); found {
+		wd, err := f.parseValue(wdText, tok.pos)
+		if err != nil {
+			return nil, err
+		}
+		return LastWeekday(time.Weekday(wd)), nil
+	}
+	wdText, nText, _ := strings.Cut(tok.text, "#")
+	wd, err := f.parseValue(wdText, tok.pos)
+	if err != nil {
+		return nil, err
+	}
+	nPos := tok.pos + len(wdText) + 1
+	n, bad, ok := parseNumber(nText)
+	if !ok || n < 1 || n > 5 {
+		return nil, f.error(nPos+bad, "invalid occurrence "+strconv.Quote(nText))
+	}
+	return NthWeekday(time.Weekday(wd), n), nil
+}
+
+func (f field) parseRange(item string, pos int) (fieldRange, error) {
+	r := fieldRange{step: 1}
+	body, stepText, hasStep := strings.Cut(item, "/")
+	switch body {
+	case "":
+		return r, f.error(pos, "missing value")
+	case "*":
+		r.x, r.y = f.min, f.max
+	case "?":
+		if !f.placeholder {
+			return r, f.error(pos, "unexpected \"?\"")
+		}
+		if hasStep {
+			return r, f.error(pos+1, "unexpected step after \"?\"")
+		}
+		r.x, r.y = f.min, f.max
+	default:
+		xText, yText, isRange := strings.Cut(body, "-")
+		x, err := f.parseValue(xText, pos)
+		if err != nil {
+			return r, err
+		}
+		r.x, r.y, r.single = x, x, !hasStep
+		if hasStep {
+			r.y = f.max
+		}
+		if isRange {
+			yPos := pos + len(xText) + 1
+			if r.y, err = f.parseValue(yText, yPos); err != nil {
+				return r, err
+			}
+			// "0-7" covers the whole week, while other ranges ending with 7 end on Sunday ("5-7", "7-7").
+			if f.sundaySeven() && xText == "0" && yText == "7" {
+				r.y = int(time.Saturday)
+			}
+			if r.x > r.y {
+				if !f.wraps() {
+					return r, f.error(pos, "range start "+xText+" is greater than range end "+yText)
+				}
+				r.cyclic = true
+			}
+			r.single = false
+		}
+	}
+
+	if hasStep {
+		stepPos := pos + len(body) + 1
+		step, bad, ok := parseNumber(stepText)
+		if !ok || step < 1 {
+			return r, f.error(stepPos+bad, "invalid step "+strconv.Quote(stepText))
+		}
+		r.step = step
+	}
+	return r, nil
+}
+
+func (f field) parseValue(text string, pos int) (int, error) {
+	if v, found := f.parseName(text); found {
+		return v, nil
+	}
+	v, bad, ok := parseNumber(text)
+	if !ok {
+		return 0, f.error(pos+bad, "invalid value "+strconv.Quote(text))
+	}
+	if f.sundaySeven() && v == 7 {
+		return int(time.Sunday), nil
+	}
+	if !f.contains(v - f.offset) {
+		return 0, f.error(pos, "value "+text+" out of range ["+strconv.Itoa(f.min+f.offset)+"-"+strconv.Itoa(f.max+f.offset)+"]")
+	}
+	return v - f.offset, nil
+}
+
+// parseName parses the English name of a month or weekday, either complete or abbreviated to three letters.
+func (f field) parseName(text string) (int, bool) {
+	switch f.unit {
+	case monthUnit:
+		for mon := time.January; mon <= time.December; mon++ {
+			if matchesName(text, mon.String()) {
+				return int(mon), true
+			}
+		}
+	case weekdayUnit:
+		for wd := time.Sunday; wd <= time.Saturday; wd++ {
+			if matchesName(text, wd.String()) {
+				return int(wd), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// sundaySeven verifies if this field accepts 7 as Sunday, as crontab does.
+func (f field) sundaySeven() bool {
+	return f.unit == weekdayUnit && f.offset == 0
+}
+
+func (f field) expression(r fieldRange) Expression {
+	if r.single {
+		switch f.unit {
+		case weekdayUnit:
+			return time.Weekday(r.x)
+		case monthUnit:
+			return time.Month(r.x)
+		}
+		return r.x
+	}
+	if r.cyclic {
+		return cyclic(r.x, r.y, f.unit).Every(r.step)
+	}
+	return Between(r.x, r.last()).Every(r.step)
+}
+
+// wraps verifies if ranges of this field may wrap around the end of its unit.
+func (f field) wraps() bool {
+	return f.unit == hourUnit || f.unit == weekdayUnit || f.unit == monthUnit
+}
+
+func (f field) error(pos int, reason string) error {
+	return &ErrorParse{Field: f.id.String(), Position: pos, Reason: reason}
+}
+
+// last returns the last value of the range reachable with its step.
+func (r fieldRange) last() int {
+	return r.y - (r.y-r.x)%r.step
+}
+
+func (r fieldRange) count(u unit) int {
+	if r.cyclic {
+		return (r.y-r.x+u.max-u.min+1)/r.step + 1
+	}
+	return (r.y-r.x)/r.step + 1
+}
+
+func (r fieldRange) appendValues(values []int, u unit) []int {
+	if r.cyclic {
+		return appendCycle(values, r.x, r.y, r.step, u)
+	}
+	for v := r.x; v <= r.y; v += r.step {
+		values = append(values, v)
+	}
+	return values
+}
+
+// parseNumber parses an unsigned decimal number.
+// When invalid, it returns the offset of the offending character.
+func parseNumber(text string) (int, int, bool) {
+	if text == "" {
+		return 0, 0, false
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] < '0' || text[i] > '9' {
+			return 0, i, false
+		}
+	}
+	v, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, 0, false
+	}
+	return v, 0, true
+}
+
+func matchesName(text string, name string) bool {
+	return strings.EqualFold(text, name) || strings.EqualFold(text, name[:3])
+}
+
+func unique(values []int) []int {
+	seen := make(map[int]bool, len(values))
+	result := values[:0]
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -1,6 +1,9 @@
 package schedule
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 // Schedule is the struct used to represent a set of retrievable time.Time structs.
 type Schedule struct {
@@ -8,29 +11,54 @@ type Schedule struct {
 	crn  *CronExpression
 	crnI *CronInstance
 
+	anchor time.Time
+	every  time.Duration
+	window *CronExpression
+
 	followingIndex int
+	// pending is set while the date a schedule created by As is positioned on was not yielded by an iterator yet.
+	pending bool
 }
 
 // At creates a new schedule that produces the dates provided.
 func At(at ...time.Time) *Schedule {
+	sch, err := NewAt(at...)
+	if err != nil {
+		panic(err.Error())
+	}
+	return sch
+}
+
+// NewAt creates a new schedule that produces the dates provided.
+// Unlike At, it returns an ErrorInvalidSchedule instead of panicking when the dates provided are invalid.
+func NewAt(at ...time.Time) (*Schedule, error) {
 	if len(at) == 0 {
-		panic("schedule: at least one time must be provided")
+		return nil, NoTimesError
 	}
 
+	if err := validateOrder(at); err != nil {
+		return nil, err
+	}
 	sch := &Schedule{
 		at:             make([]time.Time, len(at)),
 		followingIndex: -1,
 	}
+	copy(sch.at, at)
+
+	return sch, nil
+}
+
+// validateOrder verifies that the dates provided are ascending, without duplicates.
+// It returns the TimeOrderError otherwise.
+func validateOrder(at []time.Time) error {
 	currentTime := time.Time{}
-	for i, t := range at {
+	for _, t := range at {
 		if t.Before(currentTime) || t.Equal(currentTime) {
-			panic("schedule: time order provided is invalid")
+			return TimeOrderError
 		}
-		sch.at[i] = t
 		currentTime = t
 	}
-
-	return sch
+	return nil
 }
 
 // In creates a new schedule that produces dates based on provided durations.
@@ -39,8 +67,18 @@ func At(at ...time.Time) *Schedule {
 // 		date = time.Now().Add(time.Second);
 //		date = date.Add(time.Minute).
 func In(in ...time.Duration) *Schedule {
+	sch, err := NewIn(in...)
+	if err != nil {
+		panic(err.Error())
+	}
+	return sch
+}
+
+// NewIn creates a new schedule that produces dates based on provided durations.
+// Unlike In, it returns an ErrorInvalidSchedule instead of panicking when no durations are provided.
+func NewIn(in ...time.Duration) (*Schedule, error) {
 	if len(in) == 0 {
-		panic("schedule: at least one duration must be provided")
+		return nil, NoDurationsError
 	}
 
 	sch := &Schedule{
@@ -54,7 +92,7 @@ func In(in ...time.Duration) *Schedule {
 		sch.at[i] = currentTime
 	}
 
-	return sch
+	return sch, nil
 }
 
 // As creates a new schedule that produces dates based on the provided CronExpression.
@@ -62,13 +100,64 @@ func In(in ...time.Duration) *Schedule {
 // 		date = 00:00:00 of the following day;
 // 		...
 func As(crn *CronExpression) *Schedule {
-	crnI := crn.NewInstance(time.Now())
-	if err := crnI.Next(); err != nil {
+	sch, err := NewAs(crn)
+	if err != nil {
 		panic("schedule: invalid CronExpression provided")
 	}
+	return sch
+}
+
+// NewAs creates a new schedule that produces dates based on the provided CronExpression.
+// Unlike As, it returns the CronExpression's error instead of panicking when it produces no dates.
+func NewAs(crn *CronExpression) (*Schedule, error) {
+	crnI := crn.NewInstance(time.Now())
+	if err := crnI.nextDate(); err != nil {
+		return nil, err
+	}
+	return &Schedule{
+		crn:     crn,
+		crnI:    crnI,
+		pending: true,
+	}, nil
+}
+
+// Every creates a new schedule that produces dates spaced exactly by the provided duration, aligned on the anchor.
+// Unlike BetweenExpression.Every, the spacing is kept across minute, hour and day boundaries.
+// The dates produced are anchor + k*d, starting with the first one after time.Now().
+// Example: Every(7 * time.Minute, midnight):
+// 		date = midnight + k*7m, the first one after time.Now();
+// 		date = date.Add(7 * time.Minute).
+func Every(d time.Duration, anchor time.Time) *Schedule {
+	sch, err := NewEvery(d, anchor)
+	if err != nil {
+		panic(err.Error())
+	}
+	return sch
+}
+
+// NewEvery creates a new schedule that produces dates spaced exactly by the provided duration, aligned on the anchor.
+// Unlike Every, it returns an ErrorInvalidSchedule instead of panicking when the duration is not positive.
+func NewEvery(d time.Duration, anchor time.Time) (*Schedule, error) {
+	if d <= 0 {
+		return nil, NoIntervalError
+	}
+	now := time.Now()
+	// time.Time.Sub saturates after about 292 years, so a distant anchor is first moved forward in whole multiples of d.
+	for chunk := math.MaxInt64 / d * d; now.Sub(anchor) >= chunk; {
+		anchor = anchor.Add(chunk)
+	}
+	if !anchor.After(now) {
+		anchor = anchor.Add((now.Sub(anchor)/d + 1) * d)
+	}
+	return interval(anchor, d), nil
+}
+
+// interval creates a new schedule that produces the anchor date followed by dates spaced by the provided duration.
+func interval(anchor time.Time, every time.Duration) *Schedule {
 	return &Schedule{
-		crn:  crn,
-		crnI: crnI,
+		anchor:         anchor,
+		every:          every,
+		followingIndex: -1,
 	}
 }
 
@@ -81,20 +170,149 @@ func (sch *Schedule) AddCron(crn *CronExpression) {
 	sch.crn = crn
 }
 
+// AddWindow is used to restrict the dates produced by an interval schedule to the ones matched by the CronExpression.
+// The window is matched by minute, like a crontab: a date is kept when its minute holds a date matched by the window.
+// Example: Every(7 * time.Minute, midnight).AddWindow(Parse("* 9-16 * * 1-5")):
+// 		date = midnight + k*7m, only during business hours.
+func (sch *Schedule) AddWindow(crn *CronExpression) {
+	sch.window = crn
+}
+
 // Next is used to determine the following date to be produced.
+// Dates skipped by daylight saving time transitions are passed over.
+// It returns an ErrorCron describing why, when no following date can be produced.
 func (sch *Schedule) Next() error {
+	sch.pending = false
+	if sch.every > 0 {
+		return sch.nextInterval()
+	}
 	if sch.followingIndex < len(sch.at)-1 {
 		sch.followingIndex++
 		return nil
 	}
 	if sch.crn == nil {
-		return OutdatedError
+		return ExhaustedError
 	}
 	if sch.crnI == nil {
 		sch.crnI = sch.crn.NewInstance(sch.at[sch.followingIndex])
 		sch.followingIndex++
 	}
-	return sch.crnI.Next()
+	return sch.crnI.nextDate()
+}
+
+// maxWindowJumps limits how many times an interval schedule may jump ahead to the next opening of its window.
+const maxWindowJumps = 10000
+
+// nextInterval moves an interval schedule to its following date, jumping ahead to the next opening of its window when
+// the date falls outside of it.
+func (sch *Schedule) nextInterval() error {
+	k := int64(sch.followingIndex) + 1
+	for jumps := 0; jumps < maxWindowJumps; jumps++ {
+		if k < 0 || k > math.MaxInt64/int64(sch.every) {
+			return ExhaustedError
+		}
+		at := sch.anchor.Add(time.Duration(k) * sch.every)
+		if sch.window == nil {
+			sch.followingIndex = int(k)
+			return nil
+		}
+
+		minute := truncateMinute(at)
+		crnI := sch.window.NewInstance(minute.Add(-time.Millisecond))
+		if err := crnI.nextDate(); err != nil {
+			return err
+		}
+		opening := truncateMinute(crnI.Following())
+		if !opening.After(minute) {
+			sch.followingIndex = int(k)
+			return nil
+		}
+		offset := opening.Sub(sch.anchor)
+		if offset == math.MaxInt64 {
+			return ExhaustedError
+		}
+		k = int64(offset / sch.every)
+		if offset%sch.every != 0 {
+			k++
+		}
+	}
+	return UnsatisfiableError
+}
+
+// Previous is used to move the schedule back to the date produced before its following date.
+// It returns an ErrorCron describing why, when no previous date can be produced, leaving the schedule unchanged.
+func (sch *Schedule) Previous() error {
+	sch.pending = false
+	if sch.every > 0 {
+		return sch.prevInterval()
+	}
+	if sch.crnI != nil && sch.followingIndex >= len(sch.at) {
+		err := sch.crnI.previousDate()
+		if len(sch.at) == 0 || err != nil && err != ExhaustedError ||
+			err == nil && sch.crnI.Following().After(sch.at[len(sch.at)-1]) {
+			return err
+		}
+		// the CronExpression has no dates left after the scheduled times, so the last of them precedes.
+		sch.crnI = nil
+		sch.followingIndex = len(sch.at) - 1
+		return nil
+	}
+	if sch.followingIndex < 1 {
+		return ExhaustedError
+	}
+	sch.followingIndex--
+	return nil
+}
+
+// prevInterval moves an interval schedule to its previous date, jumping back to the previous closing of its window when
+// the date falls outside of it. The anchor is moved back instead of producing negative indexes.
+func (sch *Schedule) prevInterval() error {
+	anchor, k := sch.anchor, int64(sch.followingIndex)-1
+	if sch.followingIndex < 0 {
+		k = -1
+	}
+	for jumps := 0; jumps < maxWindowJumps; jumps++ {
+		if k < 0 {
+			anchor, k = anchor.Add(time.Duration(k)*sch.every), 0
+		}
+		at := anchor.Add(time.Duration(k) * sch.every)
+		if sch.window == nil {
+			sch.anchor, sch.followingIndex = anchor, int(k)
+			return nil
+		}
+
+		minute := truncateMinute(at)
+		crnI := sch.window.NewInstance(minute.Add(time.Minute))
+		if err := crnI.previousDate(); err != nil {
+			return err
+		}
+		if !crnI.Following().Before(minute) {
+			sch.anchor, sch.followingIndex = anchor, int(k)
+			return nil
+		}
+		closing := truncateMinute(crnI.Following()).Add(time.Minute - time.Nanosecond)
+		k = int64(closing.Sub(anchor) / sch.every)
+		if closing.Sub(anchor)%sch.every < 0 {
+			k--
+		}
+	}
+	return UnsatisfiableError
+}
+
+// Peek returns up to n of the dates after the following date without modifying the schedule's state.
+// Like CronInstance.Peek, it does not include the following date, even for the schedules created by As.
+func (sch *Schedule) Peek(n int) []time.Time {
+	clone := *sch
+	clone.pending = false
+	if sch.crnI != nil {
+		clone.crnI = sch.crnI.clone()
+	}
+	return peek(clone.All(), n)
+}
+
+// truncateMinute zeroes the seconds and smaller fields of the date.
+func truncateMinute(t time.Time) time.Time {
+	return t.Add(-(time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())))
 }
 
 // Following returns the determined following date.
@@ -102,6 +320,9 @@ func (sch *Schedule) Following() time.Time {
 	if sch.followingIndex < 0 {
 		return time.Time{}
 	}
+	if sch.every > 0 {
+		return sch.anchor.Add(time.Duration(sch.followingIndex) * sch.every)
+	}
 	if sch.followingIndex < len(sch.at) {
 		return sch.at[sch.followingIndex]
 	}
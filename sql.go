@@ -0,0 +1,31 @@
+package schedule
+
+import "database/sql/driver"
+
+// Scan implements the sql.Scanner interface, parsing the textual representation of a CronExpression.
+// Standard crontab expressions, macros and Quartz expressions are accepted.
+// Invalid expressions produce an *ErrorParse.
+func (crn *CronExpression) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case string:
+		return crn.UnmarshalText([]byte(src))
+	case []byte:
+		return crn.UnmarshalText(src)
+	}
+	return ScanError
+}
+
+// Value implements the driver.Valuer interface, producing the textual representation of this expression (see Text).
+// A nil expression produces a NULL value.
+// Expressions without an exact textual representation produce the UnrepresentableError, instead of being stored as a
+// different expression.
+func (crn *CronExpression) Value() (driver.Value, error) {
+	if crn == nil {
+		return nil, nil
+	}
+	text, err := crn.Text()
+	if err != nil {
+		return nil, err
+	}
+	return text, nil
+}
@@ -1,49 +1,173 @@
 package schedule
 
-func validateMillisecond(t int) {
-	if t < 0 || t > 999 {
-		panic("schedule: invalid millisecond value")
+import "time"
+
+// CronField identifies a field of a CronExpression.
+type CronField int
+
+const (
+	// MillisecondField identifies the milliseconds of a CronExpression.
+	MillisecondField CronField = iota
+	// SecondField identifies the seconds of a CronExpression.
+	SecondField
+	// MinuteField identifies the minutes of a CronExpression.
+	MinuteField
+	// HourField identifies the hours of a CronExpression.
+	HourField
+	// DayField identifies the days of the month of a CronExpression.
+	DayField
+	// WeekdayField identifies the days of the week of a CronExpression.
+	WeekdayField
+	// MonthField identifies the months of a CronExpression.
+	MonthField
+	// YearField identifies the years of a CronExpression.
+	YearField
+	// YearDayField identifies the days of the year of a CronExpression.
+	YearDayField
+	// ISOWeekField identifies the ISO 8601 week numbers of a CronExpression.
+	ISOWeekField
+)
+
+var cronFieldNames = [...]string{
+	MillisecondField: "millisecond",
+	SecondField:      "second",
+	MinuteField:      "minute",
+	HourField:        "hour",
+	DayField:         "day",
+	WeekdayField:     "weekday",
+	MonthField:       "month",
+	YearField:        "year",
+	YearDayField:     "year day",
+	ISOWeekField:     "ISO week",
+}
+
+// String returns the name of this field.
+func (f CronField) String() string {
+	return cronFieldNames[f]
+}
+
+// unit is used to represent the valid range of values of a cron field.
+type unit struct {
+	id  CronField
+	min int
+	max int
+}
+
+var (
+	millisecondUnit = unit{id: MillisecondField, min: 0, max: 999}
+	secondUnit      = unit{id: SecondField, min: 0, max: 59}
+	minuteUnit      = unit{id: MinuteField, min: 0, max: 59}
+	hourUnit        = unit{id: HourField, min: 0, max: 23}
+	dayUnit         = unit{id: DayField, min: 1, max: 31}
+	weekdayUnit     = unit{id: WeekdayField, min: 0, max: 6}
+	monthUnit       = unit{id: MonthField, min: 1, max: 12}
+	yearUnit        = unit{id: YearField, min: 1970, max: 200000000}
+	yearDayUnit     = unit{id: YearDayField, min: 1, max: 366}
+	isoWeekUnit     = unit{id: ISOWeekField, min: 1, max: 53}
+)
+
+func (u unit) contains(t int) bool {
+	return t >= u.min && t <= u.max
+}
+
+func (u unit) check(t int) error {
+	if !u.contains(t) {
+		return &ErrorInvalidValue{Field: u.id, Value: t}
 	}
+	return nil
 }
 
-func validateSecond(t int) {
-	if t < 0 || t > 59 {
-		panic("schedule: invalid second value")
+func (u unit) validate(t int) {
+	if err := u.check(t); err != nil {
+		panic(err.Error())
 	}
 }
 
-func validateMinute(t int) {
-	if t < 0 || t > 59 {
-		panic("schedule: invalid minute value")
+// checkExpression verifies that all the values of an expression belong to the unit.
+func (u unit) checkExpression(exp Expression) error {
+	switch exp := exp.(type) {
+	case int:
+		return u.check(exp)
+	case time.Month:
+		return u.check(int(exp))
+	case time.Weekday:
+		return u.check(int(exp))
+	case *BetweenExpression:
+		if err := u.check(exp.x); err != nil {
+			return err
+		}
+		return u.check(exp.y)
+	case *ListExpression:
+		for _, v := range exp.values {
+			if err := u.check(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *UnionExpression:
+		return u.checkExpressions(exp.exps)
+	case *IntersectExpression:
+		return u.checkExpressions(exp.exps)
+	case *ExceptExpression:
+		if err := u.checkExpression(exp.exp); err != nil {
+			return err
+		}
+		return u.checkExpressions(exp.excluded)
+	case IteratorExpression:
+		return nil
+	case DayExpression:
+		if u == dayUnit || u == weekdayUnit {
+			return nil
+		}
 	}
+	return UnsupportedExpressionError
 }
 
-func validateHour(t int) {
-	if t < 0 || t > 23 {
-		panic("schedule: invalid hour value")
+func (u unit) checkExpressions(exps []IteratorExpression) error {
+	for _, exp := range exps {
+		if err := u.checkExpression(exp); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+func validateMillisecond(t int) {
+	millisecondUnit.validate(t)
+}
+
+func validateSecond(t int) {
+	secondUnit.validate(t)
+}
+
+func validateMinute(t int) {
+	minuteUnit.validate(t)
+}
+
+func validateHour(t int) {
+	hourUnit.validate(t)
 }
 
 func validateDay(t int) {
-	if t < 1 || t > 31 {
-		panic("schedule: invalid day value")
-	}
+	dayUnit.validate(t)
 }
 
 func validateWeekday(t int) {
-	if t < 0 || t > 6 {
-		panic("schedule: invalid weekday value")
-	}
+	weekdayUnit.validate(t)
 }
 
 func validateMonth(t int) {
-	if t < 1 || t > 12 {
-		panic("schedule: invalid month value")
-	}
+	monthUnit.validate(t)
 }
 
 func validateYear(t int) {
-	if t < 1970 || t > 200000000 {
-		panic("schedule: invalid year value")
-	}
+	yearUnit.validate(t)
+}
+
+func validateYearDay(t int) {
+	yearDayUnit.validate(t)
+}
+
+func validateISOWeek(t int) {
+	isoWeekUnit.validate(t)
 }